@@ -0,0 +1,175 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/keeper"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// EndBlocker settles every auction whose end time has passed, refunds expired offers and
+// collection offers, delists expired market place orders, prunes old filled orders and
+// tallies the community proposals whose voting period ended. Every item runs in its own cache
+// context so that a failing item cannot halt the chain. An auction that fails to settle is aborted
+// and its highest bid refunded, other failing items leave their queue and can still be cancelled.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	var ended []types.Auction
+	k.IterateEndedAuctions(ctx, ctx.BlockTime(), func(auction types.Auction) bool {
+		ended = append(ended, auction)
+		return false
+	})
+
+	for _, auction := range ended {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SettleAuction(cacheCtx, auction); err != nil {
+			k.Logger(ctx).Error("failed to settle auction", "denom_id", auction.DenomId, "nft_id", auction.NftId, "err", err)
+			abortAuction(ctx, k, auction)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventSettleAuction{
+				Id:      auction.NftId,
+				DenomId: auction.DenomId,
+				Seller:  auction.Seller,
+				Winner:  auction.HighestBidder,
+				Price:   auction.HighestBid,
+			},
+		)
+	}
+
+	var expired []types.Offer
+	k.IterateExpiredOffers(ctx, ctx.BlockTime(), func(offer types.Offer) bool {
+		expired = append(expired, offer)
+		return false
+	})
+
+	for _, offer := range expired {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ExpireOffer(cacheCtx, offer); err != nil {
+			k.Logger(ctx).Error("failed to expire offer", "denom_id", offer.DenomId, "nft_id", offer.NftId, "err", err)
+			k.DequeueOffer(ctx, offer)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventOfferExpired{
+				Id:      offer.NftId,
+				DenomId: offer.DenomId,
+				Bidder:  offer.Bidder,
+			},
+		)
+	}
+
+	var expiredCollectionOffers []types.CollectionOffer
+	k.IterateExpiredCollectionOffers(ctx, ctx.BlockTime(), func(offer types.CollectionOffer) bool {
+		expiredCollectionOffers = append(expiredCollectionOffers, offer)
+		return false
+	})
+
+	for _, offer := range expiredCollectionOffers {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ExpireCollectionOffer(cacheCtx, offer); err != nil {
+			k.Logger(ctx).Error("failed to expire collection offer", "denom_id", offer.DenomId, "offer_id", offer.Id, "err", err)
+			k.DequeueCollectionOffer(ctx, offer)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventCollectionOfferExpired{
+				OfferId: offer.Id,
+				DenomId: offer.DenomId,
+				Bidder:  offer.Bidder,
+			},
+		)
+	}
+
+	var expiredListings []types.MarketPlace
+	k.IterateExpiredListings(ctx, ctx.BlockTime(), func(order types.MarketPlace) bool {
+		expiredListings = append(expiredListings, order)
+		return false
+	})
+
+	for _, order := range expiredListings {
+		if order.Filled {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ExpireListing(cacheCtx, order); err != nil {
+			k.Logger(ctx).Error("failed to expire listing", "denom_id", order.DenomID, "nft_id", order.NftId, "err", err)
+			k.DequeueListing(ctx, order)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventListingExpired{
+				Id:      order.NftId,
+				DenomId: order.DenomID,
+				Seller:  order.Seller,
+			},
+		)
+	}
+
+	if retention := k.FilledOrderRetention(ctx); retention > 0 {
+		var prunable []types.MarketPlace
+		k.IterateFilledOrders(ctx, ctx.BlockTime().Add(-retention), func(order types.MarketPlace) bool {
+			prunable = append(prunable, order)
+			return false
+		})
+
+		for _, order := range prunable {
+			k.DeleteMarketPlaceNFT(ctx, order.DenomID, order.NftId)
+		}
+	}
+
+	var endedProposals []types.CommunityProposal
+	k.IterateEndedProposals(ctx, ctx.BlockTime(), func(proposal types.CommunityProposal) bool {
+		endedProposals = append(endedProposals, proposal)
+		return false
+	})
+
+	for _, proposal := range endedProposals {
+		proposal = k.EndCommunityProposal(ctx, proposal)
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventCommunityProposalResult{
+				Id:         proposal.CommunityId,
+				ProposalId: proposal.Id,
+				Status:     proposal.Status.String(),
+				Yes:        proposal.FinalTally.Yes,
+				No:         proposal.FinalTally.No,
+				Abstain:    proposal.FinalTally.Abstain,
+			},
+		)
+	}
+}
+
+// abortAuction refunds the highest bid of an auction that failed to settle and returns the nft to the
+// seller. If that fails too the auction leaves the settlement queue and its seller can cancel it.
+func abortAuction(ctx sdk.Context, k keeper.Keeper, auction types.Auction) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.AbortAuction(cacheCtx, auction); err != nil {
+		k.Logger(ctx).Error("failed to abort auction", "denom_id", auction.DenomId, "nft_id", auction.NftId, "err", err)
+		k.DequeueAuction(ctx, auction)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelAuction{
+			Id:      auction.NftId,
+			DenomId: auction.DenomId,
+			Seller:  auction.Seller,
+		},
+	)
+}
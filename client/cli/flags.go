@@ -20,6 +20,28 @@ const (
 	FlagRoyalties    = "royalties"
 	FlagMediaURI     = "media_uri"
 	FlagTransferable = "transferable"
+
+	FlagExpiresAt     = "expires-at"
+	FlagRoyaltyShares = "royalty-shares"
+	FlagMerkleProof   = "merkle-proof"
+	FlagMerkleRoot    = "merkle-root"
+	FlagAddresses     = "addresses"
+	FlagGateHolding   = "gate-min-holding"
+	FlagGateAction    = "gate-action"
+	FlagQuota         = "quota"
+	FlagForce         = "force"
+	FlagInviteCode    = "invite-code"
+	FlagGateDenom     = "gate-denom"
+	FlagMaxUses       = "max-uses"
+	FlagReject        = "reject"
+	FlagAddress       = "address"
+	FlagAmount        = "amount"
+	FlagTags          = "tags"
+	FlagCommunityDesc = "community-description"
+	FlagReason        = "reason"
+	FlagDenoms        = "denoms"
+	FlagNFTs          = "nfts"
+	FlagMinPrice      = "min-price"
 )
 
 var (
@@ -29,6 +51,13 @@ var (
 	FsTransferNFT = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQuerySupply = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQueryOwner  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMakeOffer   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSellNFT     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAllowlist   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGrantMinter = flag.NewFlagSet("", flag.ContinueOnError)
+	FsProposal    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBadge       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAuthz       = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -37,6 +66,9 @@ func init() {
 	FsCreateDenom.String(FlagSymbol, "", "The symbol of the denom")
 	FsCreateDenom.String(FlagDescription, "", "Description of the denom")
 	FsCreateDenom.String(FlagPreviewURI, "", "preview_uri of the denom")
+	FsCreateDenom.String(FlagRoyaltyShares, "", "Comma separated address=share royalty recipients, e.g. addr1=0.05,addr2=0.02")
+	FsCreateDenom.Uint64(FlagGateHolding, 0, "Number of nfts of every dependent collection a minter must hold, if not filled, one is required")
+	FsCreateDenom.String(FlagGateAction, "hold", "What happens to the dependent nfts on mint: hold, burn or lock")
 	
 	FsMintNFT.String(FlagTokenURI, "", "URI for supplemental off-chain tokenData (should return a JSON object)")
 	FsMintNFT.String(FlagRecipient, "", "Receiver of the nft, if not filled, the default is the sender of the transaction")
@@ -46,6 +78,7 @@ func init() {
 	FsMintNFT.String(FlagMediaURI, "", "Media uri of the nft")
 	FsMintNFT.String(FlagRoyalties, "", "royalties")
 	FsMintNFT.String(FlagPreviewURI, "", "preview_uri")
+	FsMintNFT.String(FlagMerkleProof, "", "Comma separated hex merkle proof of the minter for allowlist phases")
 	
 	FsEditNFT.String(FlagTokenURI, "[do-not-modify]", "URI for supplemental off-chain tokenData (should return a JSON object)")
 	FsEditNFT.String(FlagTokenData, "[do-not-modify]", "The tokenData of nft")
@@ -61,4 +94,33 @@ func init() {
 	FsQuerySupply.String(FlagOwner, "", "The owner of a nft")
 	
 	FsQueryOwner.String(FlagDenom, "", "The name of a collection")
+
+	FsMakeOffer.String(FlagExpiresAt, "", "RFC3339 time after which the offer is refunded, if not filled, the offer never expires")
+
+	FsSellNFT.String(FlagExpiresAt, "", "RFC3339 time after which the nft is delisted, if not filled, the listing never expires")
+
+	FsAllowlist.String(FlagAddresses, "", "Comma separated addresses to add to the allowlist")
+	FsAllowlist.String(FlagMerkleRoot, "", "Hex sha256 merkle root of the allowlist")
+
+	FsGrantMinter.Uint64(FlagQuota, 0, "Number of nfts the minter can mint, if not filled, the minter is unlimited")
+	FsGrantMinter.String(FlagExpiresAt, "", "RFC3339 time the mint rights end, if not filled, the rights never expire")
+
+	FsProposal.String(FlagDescription, "", "Description of the proposal")
+	FsProposal.String(FlagAddress, "", "Recipient of a treasury_spend or address of an approve_creator proposal")
+	FsProposal.String(FlagAmount, "", "Coins sent by a treasury_spend proposal")
+	FsProposal.String(FlagCommunityDesc, "", "New description of the community for an update_metadata proposal")
+	FsProposal.String(FlagData, "", "New data of the community for an update_metadata proposal")
+	FsProposal.StringSlice(FlagTags, nil, "New tags of the community for an update_metadata proposal")
+
+	FsBadge.String(FlagTokenName, "", "Name of the badge")
+	FsBadge.String(FlagDescription, "", "Description of the badge")
+	FsBadge.String(FlagMediaURI, "", "Media uri of the badge")
+	FsBadge.String(FlagPreviewURI, "", "Preview uri of the badge")
+	FsBadge.String(FlagTokenData, "", "The origin data of the badge")
+
+	FsAuthz.StringSlice(FlagDenoms, nil, "Comma separated denoms the grantee is authorized for, if not filled, every denom for sell and mint")
+	FsAuthz.StringSlice(FlagNFTs, nil, "Comma separated denom-id/nft-id nfts the grantee can transfer once")
+	FsAuthz.String(FlagMinPrice, "", "Comma separated minimum prices of the listings of a sell authorization, e.g. 100uatn")
+	FsAuthz.Uint64(FlagQuota, 0, "Number of nfts the grantee can mint with a mint authorization")
+	FsAuthz.String(FlagExpiresAt, "", "RFC3339 time the authorization ends, if not filled, it never expires")
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,6 +29,31 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwnerCollections(),
 		GetCmdQueryNFT(),
 		GetCmdQueryMarketPlace(),
+		GetCmdQueryAuction(),
+		GetCmdQueryAuctions(),
+		GetCmdQueryDutchAuction(),
+		GetCmdQueryOffersByNFT(),
+		GetCmdQueryOffersByBidder(),
+		GetCmdQueryOffersByOwner(),
+		GetCmdQueryCollectionOffer(),
+		GetCmdQueryCollectionOffers(),
+		GetCmdQueryFeePool(),
+		GetCmdQueryCommunityFees(),
+		GetCmdQueryParams(),
+		GetCmdQueryMintPhases(),
+		GetCmdQueryMintEligibility(),
+		GetCmdQueryMinters(),
+		GetCmdQueryCommunityRoles(),
+		GetCmdQueryJoinRequests(),
+		GetCmdQueryCommunityMembers(),
+		GetCmdQueryCommunitiesByMember(),
+		GetCmdQueryCommunityTreasury(),
+		GetCmdQueryCommunityProposals(),
+		GetCmdQueryCommunityProposal(),
+		GetCmdQueryCommunityBadges(),
+		GetCmdQueryBadgesByHolder(),
+		GetCmdQueryNFTApproval(),
+		GetCmdQueryOperatorApprovals(),
 	)
 	
 	return queryCmd
@@ -267,3 +293,1058 @@ $ %s query nft marketPlace`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "auction [denomID] [NFTID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an open auction for an NFT.
+Example:
+$ %s query nft auction [denomID] [NFTID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+			if err := types.ValidateNFTID(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Auction(context.Background(), &types.QueryAuctionRequest{
+				DenomId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all open auctions.
+Example:
+$ %s query nft auctions`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Auctions(context.Background(), &types.QueryAuctionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+	return cmd
+}
+
+func GetCmdQueryDutchAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "dutch-auction [denomID] [NFTID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a dutch auction and its current price.
+Example:
+$ %s query nft dutch-auction [denomID] [NFTID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+			if err := types.ValidateNFTID(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.DutchAuction(context.Background(), &types.QueryDutchAuctionRequest{
+				DenomId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryOffersByNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "offers [denomID] [NFTID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open offers on an NFT.
+Example:
+$ %s query nft offers [denomID] [NFTID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+			if err := types.ValidateNFTID(args[1]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.OffersByNFT(context.Background(), &types.QueryOffersByNFTRequest{
+				DenomId:    args[0],
+				Id:         args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers")
+	return cmd
+}
+
+func GetCmdQueryOffersByBidder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "offers-by-bidder [bidder]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open offers made by a bidder.
+Example:
+$ %s query nft offers-by-bidder [bidder]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.OffersByBidder(context.Background(), &types.QueryOffersByBidderRequest{
+				Bidder:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-bidder")
+	return cmd
+}
+
+func GetCmdQueryOffersByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "offers-by-owner [owner]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open offers on all NFTs held by an owner.
+Example:
+$ %s query nft offers-by-owner [owner]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.OffersByOwner(context.Background(), &types.QueryOffersByOwnerRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "collection-offer [offerID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a collection offer by its id.
+Example:
+$ %s query nft collection-offer [offerID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CollectionOffer(context.Background(), &types.QueryCollectionOfferRequest{
+				OfferId: offerID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCollectionOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "collection-offers [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the collection offers of a denom, highest price first.
+Example:
+$ %s query nft collection-offers [denomID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CollectionOffers(context.Background(), &types.QueryCollectionOffersRequest{
+				DenomId:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collection-offers")
+	return cmd
+}
+
+func GetCmdQueryFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fee-pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the marketplace fees collected by the protocol fee pool.
+Example:
+$ %s query nft fee-pool`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.FeePool(context.Background(), &types.QueryFeePoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCommunityFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-fees [communityID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the marketplace fees collected by a community treasury.
+Example:
+$ %s query nft community-fees [communityID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityFees(context.Background(), &types.QueryCommunityFeesRequest{
+				CommunityId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the nft module parameters.
+Example:
+$ %s query nft params`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryMintPhases() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "mint-phases [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint phases of a primary sale denom.
+Example:
+$ %s query nft mint-phases [denomID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.MintPhases(context.Background(), &types.QueryMintPhasesRequest{
+				DenomId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryMintEligibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "mint-eligibility [denomID] [phaseID] [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether an address is allowlisted in a mint phase and how many nfts it minted.
+Example:
+$ %s query nft mint-eligibility [denomID] [phaseID] [address]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			phaseID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.MintEligibility(context.Background(), &types.QueryMintEligibilityRequest{
+				DenomId: args[0],
+				PhaseId: phaseID,
+				Address: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryMinters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "minters [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses allowed to mint into a denom.
+Example:
+$ %s query nft minters [denomID]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenomID(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Minters(context.Background(), &types.QueryMintersRequest{
+				DenomId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCommunityRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-roles [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members of a community with their roles.
+Example:
+$ %s query nft community-roles [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityRoles(context.Background(), &types.QueryCommunityRolesRequest{
+				CommunityId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryJoinRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "join-requests [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending join requests of a community.
+Example:
+$ %s query nft join-requests [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.JoinRequests(context.Background(), &types.QueryJoinRequestsRequest{
+				CommunityId: args[0],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "join requests")
+	return cmd
+}
+
+func GetCmdQueryCommunityMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-members [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members of a community.
+Example:
+$ %s query nft community-members [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityMembers(context.Background(), &types.QueryCommunityMembersRequest{
+				CommunityId: args[0],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "community members")
+	return cmd
+}
+
+func GetCmdQueryCommunitiesByMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "communities-by-member [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the communities an address is a member of.
+Example:
+$ %s query nft communities-by-member [address]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunitiesByMember(context.Background(), &types.QueryCommunitiesByMemberRequest{
+				Address:     args[0],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "communities")
+	return cmd
+}
+
+func GetCmdQueryCommunityTreasury() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-treasury [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of a community treasury and the funds that went in and out of it.
+Example:
+$ %s query nft community-treasury [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityTreasury(context.Background(), &types.QueryCommunityTreasuryRequest{
+				CommunityId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCommunityProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-proposals [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposals of a community with their results.
+Example:
+$ %s query nft community-proposals [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityProposals(context.Background(), &types.QueryCommunityProposalsRequest{
+				CommunityId: args[0],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "community proposals")
+	return cmd
+}
+
+func GetCmdQueryCommunityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-proposal [proposal-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a community proposal with its current tally and votes.
+Example:
+$ %s query nft community-proposal 1`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityProposal(context.Background(), &types.QueryCommunityProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCommunityBadges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "community-badges [community-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the badges issued by a community.
+Example:
+$ %s query nft community-badges [community-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.CommunityBadges(context.Background(), &types.QueryCommunityBadgesRequest{
+				CommunityId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryBadgesByHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "badges-by-holder [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the community badges held by an address.
+Example:
+$ %s query nft badges-by-holder [address]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.BadgesByHolder(context.Background(), &types.QueryBadgesByHolderRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryNFTApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "approval [denom-id] [nft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spender approved for an nft.
+Example:
+$ %s query nft approval [denom-id] [nft-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.NFTApproval(context.Background(), &types.QueryNFTApprovalRequest{
+				DenomId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryOperatorApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "operators [owner]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the operators approved for the nfts of an owner.
+Example:
+$ %s query nft operators [owner]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cliCtx, err = client.ReadPersistentCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.OperatorApprovals(context.Background(), &types.QueryOperatorApprovalsRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operators")
+	return cmd
+}
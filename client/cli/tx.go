@@ -1,13 +1,19 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 	
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	
@@ -36,6 +42,47 @@ func NewTxCmd() *cobra.Command {
 		GetCmdBuyNFT(),
 		GetCmdCreateCommunity(),
 		GetCmdJoinCommunity(),
+		GetCmdBurnNFT(),
+		GetCmdCreateAuction(),
+		GetCmdPlaceBid(),
+		GetCmdCancelAuction(),
+		GetCmdCreateDutchAuction(),
+		GetCmdMakeOffer(),
+		GetCmdCancelOffer(),
+		GetCmdAcceptOffer(),
+		GetCmdMakeCollectionOffer(),
+		GetCmdCancelCollectionOffer(),
+		GetCmdAcceptCollectionOffer(),
+		GetCmdSetMintPhases(),
+		GetCmdSetAllowlist(),
+		GetCmdSignVoucher(),
+		GetCmdRedeemVoucher(),
+		GetCmdGrantMinter(),
+		GetCmdRevokeMinter(),
+		GetCmdTransferDenomOwnership(),
+		GetCmdAcceptDenomOwnership(),
+		GetCmdDeleteCommunity(),
+		GetCmdTransferCommunityOwnership(),
+		GetCmdGrantCommunityRole(),
+		GetCmdRevokeCommunityRole(),
+		GetCmdSetMembershipPolicy(),
+		GetCmdCreateCommunityInvite(),
+		GetCmdApproveJoinRequest(),
+		GetCmdLeaveCommunity(),
+		GetCmdRemoveMember(),
+		GetCmdSetTreasuryCut(),
+		GetCmdCommunitySpend(),
+		GetCmdSetCommunityGovernance(),
+		GetCmdSubmitCommunityProposal(),
+		GetCmdVoteCommunityProposal(),
+		GetCmdCreateBadgeDenom(),
+		GetCmdIssueBadge(),
+		GetCmdRevokeBadge(),
+		GetCmdApproveNFT(),
+		GetCmdRevokeNFTApproval(),
+		GetCmdApproveOperator(),
+		GetCmdRevokeOperator(),
+		GetCmdGrantAuthorization(),
 	)
 	
 	return txCmd
@@ -50,7 +97,7 @@ func GetCmdCreateDenom() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Create a new denom.
 Example:
-$ %s tx nft create [denom] [community-id] [, seperated dependent_collections] --symbol=<symbol> --description=<description> --preview_uri=<preview_uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft create [denom] [community-id] [, seperated dependent_collections] --symbol=<symbol> --description=<description> --preview_uri=<preview_uri> --royalty-shares=<address=share,...> --gate-min-holding=<count> --gate-action=<hold|burn|lock> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -65,6 +112,16 @@ $ %s tx nft create [denom] [community-id] [, seperated dependent_collections] --
 			if len(args[2]) > 0 {
 				collections = strings.Split(args[2], ",")
 			}
+
+			royaltyShares, err := types.ParseRoyaltyShares(viper.GetString(FlagRoyaltyShares))
+			if err != nil {
+				return err
+			}
+
+			gateAction, err := types.ParseGateAction(viper.GetString(FlagGateAction))
+			if err != nil {
+				return err
+			}
 			
 			msg := types.NewMsgCreateDenom(
 				args[0],
@@ -74,6 +131,8 @@ $ %s tx nft create [denom] [community-id] [, seperated dependent_collections] --
 				clientCtx.GetFromAddress().String(),
 				args[1],
 				collections,
+				royaltyShares,
+				types.NewTokenGate(viper.GetUint64(FlagGateHolding), gateAction),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -96,7 +155,7 @@ func GetCmdMintNFT() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Mint an NFT and set the owner to the recipient.
 Example:
-$ %s tx nft mint [denomID] --media_uri=<media_uri> --preview_uri=<preview_uri> --name=<name> --description=<description> --transferable=<transferable> --royalties=<royalties> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft mint [denomID] --media_uri=<media_uri> --preview_uri=<preview_uri> --name=<name> --description=<description> --transferable=<transferable> --royalties=<royalties> --royalty-shares=<address=share,...> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -122,6 +181,11 @@ $ %s tx nft mint [denomID] --media_uri=<media_uri> --preview_uri=<preview_uri> -
 				MediaURI:    media_uri,
 				PreviewURI:  previewURI,
 			}
+
+			royaltyShares, err := types.ParseRoyaltyShares(viper.GetString(FlagRoyaltyShares))
+			if err != nil {
+				return err
+			}
 			
 			msg := types.NewMsgMintNFT(
 				args[0],
@@ -130,7 +194,18 @@ $ %s tx nft mint [denomID] --media_uri=<media_uri> --preview_uri=<preview_uri> -
 				viper.GetString(FlagRoyalties),
 				metaData,
 				viper.GetBool(FlagTransferable),
+				royaltyShares,
 			)
+
+			if proof := viper.GetString(FlagMerkleProof); len(proof) > 0 {
+				for _, node := range strings.Split(proof, ",") {
+					bz, err := hex.DecodeString(strings.TrimSpace(node))
+					if err != nil {
+						return err
+					}
+					msg.MerkleProof = append(msg.MerkleProof, bz)
+				}
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -230,6 +305,47 @@ $ %s tx nft transfer [denomID] [nftID] [recipient] --from=<key-name> --chain-id=
 	return cmd
 }
 
+// GetCmdBurnNFT is the CLI command for sending a BurnNFT transaction
+func GetCmdBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [denomID] [nftID]",
+		Short: "burn an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn an NFT owned by the sender.
+Example:
+$ %s tx nft burn [denomID] [nftID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnNFT(
+				args[1],
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdSellNFT() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sell [denomID] [NFTID] [price]",
@@ -237,7 +353,7 @@ func GetCmdSellNFT() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Add an NFT to market place.
 Example:
-$ %s tx nft sell [denomID] [NFTID] [price] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft sell [denomID] [NFTID] [price] --expires-at=2030-01-01T00:00:00Z --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -253,11 +369,25 @@ $ %s tx nft sell [denomID] [NFTID] [price] --from=<key-name> --chain-id=<chain-i
 				return err
 			}
 			
+			var expiresAt *time.Time
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(expiresAtStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				expiresAt = &t
+			}
+
 			msg := types.NewMsgSellNFT(
 				args[1],
 				args[0],
 				args[2],
 				cliCtx.GetFromAddress().String(),
+				expiresAt,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -266,6 +396,7 @@ $ %s tx nft sell [denomID] [NFTID] [price] --from=<key-name> --chain-id=<chain-i
 		},
 	}
 	
+	cmd.Flags().AddFlagSet(FsSellNFT)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -371,9 +502,15 @@ $ %s tx nft join-community [community-id]   --from=<key-name> --chain-id=<chain-
 				return err
 			}
 			
+			inviteCode, err := cmd.Flags().GetString(FlagInviteCode)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgJoinCommunity(
 				args[0],
 				clientCtx.GetFromAddress().String(),
+				inviteCode,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -383,7 +520,2082 @@ $ %s tx nft join-community [community-id]   --from=<key-name> --chain-id=<chain-
 	}
 	
 	cmd.Flags().AddFlagSet(FsCreateDenom)
+	cmd.Flags().String(FlagInviteCode, "", "Invite code of an invite only community")
 	flags.AddTxFlagsToCmd(cmd)
 	
 	return cmd
 }
+
+func GetCmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [denomID] [NFTID] [start-price] [duration]",
+		Short: "Create an english auction for an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow an NFT and open an english auction that settles once the duration has passed.
+Example:
+$ %s tx nft create-auction [denomID] [NFTID] 100uatn 24h --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(
+				args[1],
+				args[0],
+				args[2],
+				duration,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [denomID] [NFTID] [amount]",
+		Short: "Bid on an nft auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place a bid on an open auction. The amount is escrowed until the bidder is outbid or the auction settles.
+Example:
+$ %s tx nft bid [denomID] [NFTID] 150uatn --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(
+				args[1],
+				args[0],
+				args[2],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [denomID] [NFTID]",
+		Short: "Cancel an nft auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an auction that has not received any bids and return the NFT to the seller.
+Example:
+$ %s tx nft cancel-auction [denomID] [NFTID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAuction(
+				args[1],
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCreateDutchAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-dutch-auction [denomID] [NFTID] [start-price] [floor-price] [decay-amount] [decay-interval]",
+		Short: "Create a dutch auction for an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow an NFT and list it at a price that drops by the decay amount every decay interval until it reaches the floor price.
+The NFT is bought at the current price with the buy command.
+Example:
+$ %s tx nft create-dutch-auction [denomID] [NFTID] 1000uatn 100uatn 50uatn 1h --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			decayInterval, err := time.ParseDuration(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDutchAuction(
+				args[1],
+				args[0],
+				args[2],
+				args[3],
+				args[4],
+				decayInterval,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMakeOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-offer [denomID] [NFTID] [amount]",
+		Short: "Make an offer on an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make an offer on an NFT. The amount is escrowed until the offer is accepted, cancelled or expires.
+Example:
+$ %s tx nft make-offer [denomID] [NFTID] 100uatn --expires-at=2030-01-01T00:00:00Z --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var expiresAt *time.Time
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(expiresAtStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				expiresAt = &t
+			}
+
+			msg := types.NewMsgMakeOffer(
+				args[1],
+				args[0],
+				args[2],
+				expiresAt,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMakeOffer)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-offer [denomID] [NFTID]",
+		Short: "Cancel an offer on an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an offer on an NFT and refund the escrowed amount.
+Example:
+$ %s tx nft cancel-offer [denomID] [NFTID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOffer(
+				args[1],
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAcceptOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-offer [denomID] [NFTID] [bidder]",
+		Short: "Accept an offer on an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept an offer on an NFT owned by the sender. The NFT is transferred to the bidder and the escrowed amount is paid out.
+Example:
+$ %s tx nft accept-offer [denomID] [NFTID] [bidder] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOffer(
+				args[1],
+				args[0],
+				args[2],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMakeCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-collection-offer [denomID] [price] [quantity]",
+		Short: "Make an offer on any nft of a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make an offer to buy up to quantity NFTs of a collection at the given price each.
+The price times the quantity is escrowed until the offer is filled, cancelled or expires.
+Example:
+$ %s tx nft make-collection-offer [denomID] 50uatn 3 --expires-at=2030-01-01T00:00:00Z --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			quantity, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var expiresAt *time.Time
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(expiresAtStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				expiresAt = &t
+			}
+
+			msg := types.NewMsgMakeCollectionOffer(
+				args[0],
+				args[1],
+				quantity,
+				expiresAt,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMakeOffer)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-collection-offer [offerID]",
+		Short: "Cancel a collection offer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a collection offer and refund the unfilled amount.
+Example:
+$ %s tx nft cancel-collection-offer [offerID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelCollectionOffer(
+				offerID,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAcceptCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-collection-offer [offerID] [NFTID]",
+		Short: "Sell an nft to a collection offer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell an NFT owned by the sender to a collection offer on its denom.
+Example:
+$ %s tx nft accept-collection-offer [offerID] [NFTID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptCollectionOffer(
+				offerID,
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetMintPhases() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-phases [denomID] [phases-file]",
+		Short: "Set the mint phases of a primary sale denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the mint phases of a primary sale denom with the phases of a JSON file.
+Example:
+$ %s tx nft set-mint-phases [denomID] phases.json --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+
+Where phases.json contains:
+{
+  "phases": [
+    {
+      "id": "1",
+      "name": "presale",
+      "start_time": "2030-01-01T00:00:00Z",
+      "end_time": "2030-01-02T00:00:00Z",
+      "price": "100uatn",
+      "wallet_limit": "2",
+      "supply": "500",
+      "allowlist_only": true
+    }
+  ]
+}`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var phases types.MsgSetMintPhases
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &phases); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintPhases(
+				args[0],
+				phases.Phases,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-allowlist [denomID] [phaseID]",
+		Short: "Add addresses or a merkle root to the allowlist of a mint phase",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add addresses or a merkle root to the allowlist of a mint phase.
+Example:
+$ %s tx nft set-allowlist [denomID] [phaseID] --addresses=<address>,<address> --merkle-root=<hex-root> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			phaseID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			addressesStr, err := cmd.Flags().GetString(FlagAddresses)
+			if err != nil {
+				return err
+			}
+
+			var addresses []string
+			if len(addressesStr) > 0 {
+				addresses = strings.Split(addressesStr, ",")
+			}
+
+			merkleRootStr, err := cmd.Flags().GetString(FlagMerkleRoot)
+			if err != nil {
+				return err
+			}
+
+			merkleRoot, err := hex.DecodeString(merkleRootStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAllowlist(
+				args[0],
+				phaseID,
+				addresses,
+				merkleRoot,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAllowlist)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSignVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-voucher [voucher-file]",
+		Short: "Sign a mint voucher of a denom you created",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a mint voucher offline with the key of the denom creator and print the hex signature.
+Example:
+$ %s tx nft sign-voucher voucher.json --from=<key-name>
+
+Where voucher.json contains:
+{
+  "chain_id": "autonomy",
+  "denom_id": "<denomID>",
+  "nft_id": "<nftID>",
+  "metadata": {"name": "<name>", "media_uri": "<media_uri>"},
+  "royalties": "0.05",
+  "price": "100uatn",
+  "expires_at": "2030-01-01T00:00:00Z",
+  "nonce": "1"
+}`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voucher, err := readMintVoucher(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), voucher.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(hex.EncodeToString(signature) + "\n")
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRedeemVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-voucher [voucher-file] [signature]",
+		Short: "Mint the nft of a voucher signed by the denom creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint the nft of a voucher signed by the denom creator and pay its price.
+Example:
+$ %s tx nft redeem-voucher voucher.json [hex-signature] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			voucher, err := readMintVoucher(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signature, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemVoucher(
+				voucher,
+				signature,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readMintVoucher(clientCtx client.Context, path string) (types.MintVoucher, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return types.MintVoucher{}, err
+	}
+
+	var voucher types.MintVoucher
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &voucher); err != nil {
+		return types.MintVoucher{}, err
+	}
+	return voucher, voucher.Validate()
+}
+
+func GetCmdGrantMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-minter [denomID] [minter]",
+		Short: "Allow an address to mint into a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an address to mint into a denom, granting an existing minter again replaces its quota and expiry.
+Example:
+$ %s tx nft grant-minter [denomID] [minter] --quota=<quota> --expires-at=<expires-at> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			quota, err := cmd.Flags().GetUint64(FlagQuota)
+			if err != nil {
+				return err
+			}
+
+			var expiresAt *time.Time
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(expiresAtStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				expiresAt = &t
+			}
+
+			msg := types.NewMsgGrantMinter(
+				args[0],
+				args[1],
+				quota,
+				expiresAt,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsGrantMinter)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRevokeMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-minter [denomID] [minter]",
+		Short: "Remove the mint rights of an address on a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the mint rights of an address on a denom.
+Example:
+$ %s tx nft revoke-minter [denomID] [minter] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeMinter(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdTransferDenomOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-denom-ownership [denomID] [recipient]",
+		Short: "Propose a new owner for a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Propose a new owner for a denom, the recipient becomes the owner once it accepts.
+Example:
+$ %s tx nft transfer-denom-ownership [denomID] [recipient] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferDenomOwnership(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAcceptDenomOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-denom-ownership [denomID]",
+		Short: "Accept the ownership of a denom proposed to you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept the ownership of a denom proposed to you.
+Example:
+$ %s tx nft accept-denom-ownership [denomID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptDenomOwnership(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdDeleteCommunity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-community [community-id]",
+		Short: "Delete a community without denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delete a community without denoms, with --force a community with denoms is archived instead.
+Example:
+$ %s tx nft delete-community [community-id] --force --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			force, err := cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteCommunity(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+				force,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagForce, false, "Archive the community if it has denoms")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdTransferCommunityOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-community-ownership [community-id] [recipient]",
+		Short: "Make another address the creator of a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make another address the creator of a community.
+Example:
+$ %s tx nft transfer-community-ownership [community-id] [recipient] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferCommunityOwnership(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdGrantCommunityRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-community-role [community-id] [address] [role]",
+		Short: "Give an address a role in a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Give an address a member, creator, moderator or admin role in a community.
+Example:
+$ %s tx nft grant-community-role [community-id] [address] creator --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseCommunityRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantCommunityRole(
+				args[0],
+				args[1],
+				role,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRevokeCommunityRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-community-role [community-id] [address]",
+		Short: "Turn an address with a role back into a plain member of a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Turn an address with a role back into a plain member of a community.
+Example:
+$ %s tx nft revoke-community-role [community-id] [address] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeCommunityRole(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetMembershipPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-membership-policy [community-id] [policy]",
+		Short: "Change how addresses join a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the membership policy of a community to open, approval, invite or token_gated. Token gated communities need --gate-denom.
+Example:
+$ %s tx nft set-membership-policy [community-id] token_gated --gate-denom=[denomID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			policy, err := types.ParseMembershipPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			gateDenomID, err := cmd.Flags().GetString(FlagGateDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMembershipPolicy(
+				args[0],
+				policy,
+				gateDenomID,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGateDenom, "", "Denom whose nfts a member must hold to join a token gated community")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCreateCommunityInvite() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-community-invite [community-id] [code]",
+		Short: "Add an invite code to a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add an invite code to a community. Only the hash of the code is stored on chain.
+Example:
+$ %s tx nft create-community-invite [community-id] [code] --max-uses=10 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCommunityInvite(
+				args[0],
+				types.InviteCodeHash(args[1]),
+				maxUses,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxUses, 1, "Number of times the invite code can be used")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdApproveJoinRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-join-request [community-id] [address]",
+		Short: "Approve or reject a pending join request",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add the address of a pending join request to the members of a community, or drop the request with --reject.
+Example:
+$ %s tx nft approve-join-request [community-id] [address] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			reject, err := cmd.Flags().GetBool(FlagReject)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveJoinRequest(
+				args[0],
+				args[1],
+				reject,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagReject, false, "Reject the join request")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdLeaveCommunity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-community [community-id]",
+		Short: "Leave a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the sender from the members of a community.
+Example:
+$ %s tx nft leave-community [community-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLeaveCommunity(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRemoveMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-member [community-id] [address]",
+		Short: "Remove a member from a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a member with a lower role than the sender from a community.
+Example:
+$ %s tx nft remove-member [community-id] [address] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveMember(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetTreasuryCut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-treasury-cut [community-id] [cut-bps]",
+		Short: "Set the share of sales paid to a community treasury",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the share of primary sales and royalties of a community's denoms paid to its treasury, in basis points.
+Example:
+$ %s tx nft set-treasury-cut [community-id] 500 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cutBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTreasuryCut(
+				args[0],
+				uint32(cutBps),
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCommunitySpend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-spend [community-id] [recipient] [amount]",
+		Short: "Send funds from a community treasury",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send funds from the treasury of a community to a recipient. Only admins and the owner can spend.
+Example:
+$ %s tx nft community-spend [community-id] [recipient] 100uatn --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommunitySpend(
+				args[0],
+				args[1],
+				args[2],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetCommunityGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-community-governance [community-id] [voting-weight] [voting-period] [quorum-bps]",
+		Short: "Set how the proposals of a community are voted on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the voting weight (member or nft), the voting period and the quorum in basis points of the proposals of a community.
+Example:
+$ %s tx nft set-community-governance [community-id] nft 72h 2000 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			votingWeight, err := types.ParseVotingWeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			votingPeriod, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			quorumBps, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommunityGovernance(
+				args[0],
+				votingWeight,
+				votingPeriod,
+				uint32(quorumBps),
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSubmitCommunityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-community-proposal [community-id] [kind] [title]",
+		Short: "Submit a proposal the members of a community vote on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a text, treasury_spend, update_metadata or approve_creator proposal to a community.
+Example:
+$ %s tx nft submit-community-proposal [community-id] treasury_spend [title] --address=[recipient] --amount=100uatn --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			kind, err := types.ParseProposalKind(args[1])
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.CommunityMetadata
+			metadata.Description, err = cmd.Flags().GetString(FlagCommunityDesc)
+			if err != nil {
+				return err
+			}
+
+			metadata.Data, err = cmd.Flags().GetString(FlagData)
+			if err != nil {
+				return err
+			}
+
+			metadata.Tags, err = cmd.Flags().GetStringSlice(FlagTags)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitCommunityProposal(
+				args[0],
+				args[2],
+				description,
+				kind,
+				address,
+				amount,
+				metadata,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsProposal)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdVoteCommunityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-community-proposal [proposal-id] [option]",
+		Short: "Vote on a community proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote yes, no or abstain on a community proposal. Voting again replaces the previous vote.
+Example:
+$ %s tx nft vote-community-proposal 1 yes --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			option, err := types.ParseVoteOption(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteCommunityProposal(
+				proposalID,
+				option,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCreateBadgeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-badge-denom [denom-id] [community-id]",
+		Short: "Create a denom of soulbound badges issued by the admins of a community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a denom of non transferable badges bound to a community. Only admins and the owner of the community can create one.
+Example:
+$ %s tx nft create-badge-denom [denom-id] [community-id] --name=<name> --symbol=<symbol> --description=<description> --preview_uri=<preview_uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString(FlagDenomName)
+			if err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			previewURI, err := cmd.Flags().GetString(FlagPreviewURI)
+			if err != nil {
+				return err
+			}
+
+			data, err := cmd.Flags().GetString(FlagData)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateBadgeDenom(
+				args[0],
+				name,
+				symbol,
+				description,
+				previewURI,
+				args[1],
+				data,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenomName, "", "The name of the denom")
+	cmd.Flags().String(FlagSymbol, "", "The symbol of the denom")
+	cmd.Flags().String(FlagDescription, "", "Description of the denom")
+	cmd.Flags().String(FlagPreviewURI, "", "preview_uri of the denom")
+	cmd.Flags().String(FlagData, "", "Denom data")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdIssueBadge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-badge [denom-id] [recipient]",
+		Short: "Issue a soulbound badge to a member of the community",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Issue a non transferable badge of a badge denom to a member of its community.
+Example:
+$ %s tx nft issue-badge [denom-id] [recipient] --name=<name> --description=<description> --media_uri=<media_uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			metadata.Name, err = cmd.Flags().GetString(FlagTokenName)
+			if err != nil {
+				return err
+			}
+
+			metadata.Description, err = cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			metadata.MediaURI, err = cmd.Flags().GetString(FlagMediaURI)
+			if err != nil {
+				return err
+			}
+
+			metadata.PreviewURI, err = cmd.Flags().GetString(FlagPreviewURI)
+			if err != nil {
+				return err
+			}
+
+			data, err := cmd.Flags().GetString(FlagTokenData)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgIssueBadge(
+				args[0],
+				data,
+				metadata,
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsBadge)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRevokeBadge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-badge [denom-id] [badge-id]",
+		Short: "Revoke a badge from its holder",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn a badge issued to a member. Holders burn their own badges with the burn command.
+Example:
+$ %s tx nft revoke-badge [denom-id] [badge-id] --reason=<reason> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeBadge(
+				args[1],
+				args[0],
+				reason,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Why the badge is revoked")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdApproveNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [denom-id] [nft-id] [spender]",
+		Short: "Allow a spender to transfer, sell or burn an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow a spender to transfer, sell or burn an nft until it changes hands. It replaces the previous spender of the nft.
+Example:
+$ %s tx nft approve [denom-id] [nft-id] [spender] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveNFT(
+				args[1],
+				args[0],
+				args[2],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRevokeNFTApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval [denom-id] [nft-id]",
+		Short: "Remove the spender of an nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the spender of an nft. The owner, its operators and the spender itself can remove it.
+Example:
+$ %s tx nft revoke-approval [denom-id] [nft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeNFTApproval(
+				args[1],
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdApproveOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-all [operator]",
+		Short: "Allow an operator to transfer, sell or burn all your nfts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an operator to transfer, sell or burn all your nfts of a denom, or of every denom if --denom is not filled.
+Example:
+$ %s tx nft approve-all [operator] --denom=<denom-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denomID, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveOperator(
+				args[0],
+				denomID,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Denom the operator is approved for, if not filled, the operator is approved for every denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRevokeOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all [operator]",
+		Short: "Remove an operator of your nfts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove an operator approval of a denom, or the approval of every denom if --denom is not filled.
+Example:
+$ %s tx nft revoke-all [operator] --denom=<denom-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denomID, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeOperator(
+				args[0],
+				denomID,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Denom of the operator approval, if not filled, the approval of every denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-authorization [grantee] [transfer|sell|mint]",
+		Short: "Grant an address the right to transfer, sell or mint nfts for you with authz",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an authz authorization for nft messages. The grantee executes them with the authz exec command
+and the grant is revoked with the authz revoke command.
+Example:
+$ %s tx nft grant-authorization [grantee] transfer --denoms=<denom-id> --nfts=<denom-id>/<nft-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+$ %s tx nft grant-authorization [grantee] sell --min-price=100uatn --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+$ %s tx nft grant-authorization [grantee] mint --denoms=<denom-id> --quota=10 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err = client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denomIDs, err := cmd.Flags().GetStringSlice(FlagDenoms)
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			switch args[1] {
+			case "transfer":
+				nftIDs, err := cmd.Flags().GetStringSlice(FlagNFTs)
+				if err != nil {
+					return err
+				}
+
+				var nfts []types.NFTRef
+				for _, nft := range nftIDs {
+					parts := strings.Split(strings.TrimSpace(nft), "/")
+					if len(parts) != 2 {
+						return fmt.Errorf("invalid nft %s, expected denom-id/nft-id", nft)
+					}
+					nfts = append(nfts, types.NFTRef{DenomId: parts[0], NftId: parts[1]})
+				}
+				authorization = types.NewTransferNFTAuthorization(denomIDs, nfts)
+			case "sell":
+				minPriceStr, err := cmd.Flags().GetString(FlagMinPrice)
+				if err != nil {
+					return err
+				}
+
+				minPrice, err := sdk.ParseDecCoins(minPriceStr)
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSellNFTAuthorization(denomIDs, minPrice)
+			case "mint":
+				quota, err := cmd.Flags().GetUint64(FlagQuota)
+				if err != nil {
+					return err
+				}
+				authorization = types.NewMintAuthorization(denomIDs, quota)
+			default:
+				return fmt.Errorf("unknown authorization %s, expected transfer, sell or mint", args[1])
+			}
+
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiresAt *time.Time
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(expiresAtStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				expiresAt = &t
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiresAt)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAuthz)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
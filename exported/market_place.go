@@ -11,4 +11,5 @@ type MarketPlace interface {
 	GetFilled() bool
 	GetCurrency() string
 	GetFiatAmount() string
+	GetListedType() int32
 }
@@ -16,6 +16,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		panic(err.Error())
 	}
 
+	k.SetParams(ctx, data.Params)
+
 	for _, c := range data.Collections {
 		if c.Denom.PrimarySale == false {
 			c.Denom.AvailableNfts = 0
@@ -30,30 +32,131 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	}
 
 	for _, o := range data.Orders {
-		if o.Price != "" {
+		if o.Price != "" && o.ListedType == types.Unspecified {
 			o.ListedType = types.Crypto
 		}
 		k.SetNFTMarketPlace(ctx, o)
+		k.InsertOrderQueue(ctx, o)
 	}
 
 	for _, community := range data.Communities {
 		k.SetCommunity(ctx, community)
 	}
+
+	for _, auction := range data.Auctions {
+		k.SetAuction(ctx, auction)
+		k.InsertAuctionQueue(ctx, auction)
+	}
+
+	for _, auction := range data.DutchAuctions {
+		k.SetDutchAuction(ctx, auction)
+	}
+
+	for _, offer := range data.Offers {
+		k.SetOffer(ctx, offer)
+	}
+
+	nextCollectionOfferID := uint64(1)
+	for _, offer := range data.CollectionOffers {
+		k.SetCollectionOffer(ctx, offer)
+		if offer.Id >= nextCollectionOfferID {
+			nextCollectionOfferID = offer.Id + 1
+		}
+	}
+	k.SetNextCollectionOfferID(ctx, nextCollectionOfferID)
+
+	for _, fees := range data.CollectedFees {
+		k.SetCollectedFees(ctx, fees)
+	}
+
+	for _, phase := range data.MintPhases {
+		k.SetMintPhase(ctx, phase)
+	}
+
+	for _, allowlist := range data.Allowlists {
+		for _, address := range allowlist.Addresses {
+			k.SetAllowlisted(ctx, allowlist.DenomId, allowlist.PhaseId, sdk.MustAccAddressFromBech32(address))
+		}
+	}
+
+	for _, mints := range data.WalletMints {
+		k.SetWalletMints(ctx, mints.DenomId, mints.PhaseId, sdk.MustAccAddressFromBech32(mints.Address), mints.Count)
+	}
+
+	for _, voucher := range data.RedeemedVouchers {
+		k.SetVoucherRedeemed(ctx, voucher.DenomId, voucher.Nonce)
+	}
+
+	for _, lock := range data.LockedGateTokens {
+		k.SetGateLock(ctx, lock.DenomId, lock.DependentDenomId, lock.NftId)
+	}
+
+	for _, minter := range data.Minters {
+		k.SetMinter(ctx, minter)
+	}
+
+	for _, transfer := range data.DenomTransfers {
+		k.SetDenomTransfer(ctx, transfer)
+	}
+
+	for _, member := range data.CommunityMembers {
+		k.SetCommunityMember(ctx, member)
+	}
+
+	for _, request := range data.JoinRequests {
+		k.SetJoinRequest(ctx, request)
+	}
+
+	for _, invite := range data.CommunityInvites {
+		k.SetCommunityInvite(ctx, invite)
+	}
+
+	for _, flow := range data.TreasuryFlows {
+		k.SetTreasuryFlow(ctx, flow)
+	}
+
+	nextProposalID := uint64(1)
+	for _, proposal := range data.CommunityProposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.Status == types.StatusVoting {
+			k.InsertProposalQueue(ctx, proposal)
+		}
+		if proposal.Id >= nextProposalID {
+			nextProposalID = proposal.Id + 1
+		}
+	}
+	k.SetNextProposalID(ctx, nextProposalID)
+
+	for _, vote := range data.CommunityVotes {
+		k.SetVote(ctx, vote)
+	}
+
+	for _, approval := range data.NFTApprovals {
+		k.SetNFTApproval(ctx, approval)
+	}
+
+	for _, approval := range data.OperatorApprovals {
+		k.SetOperator(ctx, approval)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return types.NewGenesisState(k.GetCollections(ctx), k.GetMarketPlace(ctx), k.GetCommunities(ctx))
+	return types.NewGenesisState(k.GetCollections(ctx), k.GetMarketPlace(ctx), k.GetCommunities(ctx), k.GetAuctions(ctx), k.GetDutchAuctions(ctx), k.GetOffers(ctx), k.GetCollectionOffers(ctx), k.GetParams(ctx), k.GetAllCollectedFees(ctx), k.GetAllMintPhases(ctx), k.GetAllowlists(ctx), k.GetAllWalletMints(ctx), k.GetRedeemedVouchers(ctx), k.GetLockedGateTokens(ctx), k.GetAllMinters(ctx), k.GetDenomTransfers(ctx), k.GetAllCommunityMembers(ctx), k.GetJoinRequests(ctx), k.GetCommunityInvites(ctx), k.GetTreasuryFlows(ctx), k.GetProposals(ctx), k.GetVotes(ctx, 0), k.GetNFTApprovals(ctx), k.GetOperators(ctx))
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() *types.GenesisState {
-	return types.NewGenesisState([]types.Collection{}, []types.MarketPlace{}, []types.Community{})
+	return types.NewGenesisState([]types.Collection{}, []types.MarketPlace{}, []types.Community{}, []types.Auction{}, []types.DutchAuction{}, []types.Offer{}, []types.CollectionOffer{}, types.DefaultParams(), []types.CollectedFees{}, []types.MintPhase{}, []types.PhaseAllowlist{}, []types.WalletMints{}, []types.RedeemedVoucher{}, []types.LockedGateToken{}, []types.Minter{}, []types.DenomOwnershipTransfer{}, []types.CommunityMember{}, []types.JoinRequest{}, []types.CommunityInvite{}, []types.TreasuryFlow{}, []types.CommunityProposal{}, []types.CommunityVote{}, []types.NFTApproval{}, []types.OperatorApproval{})
 }
 
 // ValidateGenesis performs basic validation of nfts genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, c := range data.Collections {
 		if err := types.ValidateDenomID(c.Denom.Id); err != nil {
 			return err
@@ -61,6 +164,9 @@ func ValidateGenesis(data types.GenesisState) error {
 		if !utf8.ValidString(c.Denom.Name) {
 			return sdkerrors.Wrap(types.ErrInvalidDenom, "denom name is invalid")
 		}
+		if err := c.Denom.TokenGate.Validate(); err != nil {
+			return err
+		}
 
 		for _, nft := range c.NFTs {
 			if nft.GetOwner().Empty() {
@@ -76,5 +182,103 @@ func ValidateGenesis(data types.GenesisState) error {
 			}
 		}
 	}
+
+	for _, fees := range data.CollectedFees {
+		if err := fees.Amount.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid collected fees %s", err.Error())
+		}
+	}
+
+	phases := make(map[string][]types.MintPhase)
+	for _, phase := range data.MintPhases {
+		phases[phase.DenomId] = append(phases[phase.DenomId], phase)
+	}
+	for _, denomPhases := range phases {
+		if err := types.ValidateMintPhases(denomPhases); err != nil {
+			return err
+		}
+	}
+
+	for _, allowlist := range data.Allowlists {
+		for _, address := range allowlist.Addresses {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlist address %s", err)
+			}
+		}
+	}
+
+	for _, mints := range data.WalletMints {
+		if _, err := sdk.AccAddressFromBech32(mints.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid wallet mints address %s", err)
+		}
+	}
+
+	for _, minter := range data.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address %s", err)
+		}
+	}
+
+	for _, transfer := range data.DenomTransfers {
+		if _, err := sdk.AccAddressFromBech32(transfer.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid denom transfer recipient %s", err)
+		}
+	}
+
+	for _, member := range data.CommunityMembers {
+		if _, err := sdk.AccAddressFromBech32(member.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid community member address %s", err)
+		}
+		if !member.Role.IsGrantable() {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "community member %s cannot have role %s", member.Address, member.Role)
+		}
+	}
+
+	for _, request := range data.JoinRequests {
+		if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid join request address %s", err)
+		}
+	}
+
+	for _, invite := range data.CommunityInvites {
+		if len(invite.CodeHash) == 0 || invite.UsesLeft == 0 {
+			return sdkerrors.Wrapf(types.ErrMembership, "invalid invite of community %s", invite.CommunityId)
+		}
+	}
+
+	for _, flow := range data.TreasuryFlows {
+		for _, coins := range []sdk.Coins{flow.PrimarySales, flow.Royalties, flow.Spent} {
+			if err := coins.Validate(); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid treasury flow of community %s: %s", flow.CommunityId, err.Error())
+			}
+		}
+	}
+
+	for _, proposal := range data.CommunityProposals {
+		if proposal.Id == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidProposal, "invalid proposal id of community %s", proposal.CommunityId)
+		}
+		if err := types.ValidateProposalContent(proposal.Kind, proposal.Address, proposal.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range data.CommunityVotes {
+		if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address %s", err)
+		}
+	}
+
+	for _, approval := range data.NFTApprovals {
+		if err := types.ValidateNFTApproval(approval); err != nil {
+			return err
+		}
+	}
+
+	for _, approval := range data.OperatorApprovals {
+		if err := types.ValidateOperatorApproval(approval); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// ApproveNFT allows the spender to transfer, sell or burn the nft until it changes hands.
+// The owner and its operators can approve a spender, which replaces the previous one.
+func (k Keeper) ApproveNFT(ctx sdk.Context, denomID, tokenID string, spender, sender sdk.AccAddress) (types.NFT, error) {
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.NFT{}, err
+	}
+
+	owner := nft.GetOwner()
+	if !owner.Equals(sender) && !k.IsOperator(ctx, owner, sender, denomID) {
+		return types.NFT{}, sdkerrors.Wrap(types.ErrUnauthorized, sender.String())
+	}
+
+	if owner.Equals(spender) {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrUnauthorized, "cannot approve the owner %s", owner)
+	}
+
+	k.SetNFTApproval(ctx, types.NFTApproval{DenomId: denomID, NftId: tokenID, Spender: spender.String()})
+	return nft.(types.NFT), nil
+}
+
+// RevokeNFTApproval removes the spender of the nft. The owner, its operators and the spender can revoke it.
+func (k Keeper) RevokeNFTApproval(ctx sdk.Context, denomID, tokenID string, sender sdk.AccAddress) (types.NFT, types.NFTApproval, error) {
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.NFT{}, types.NFTApproval{}, err
+	}
+
+	approval, found := k.GetNFTApproval(ctx, denomID, tokenID)
+	if !found {
+		return types.NFT{}, types.NFTApproval{}, sdkerrors.Wrapf(types.ErrUnknownApproval, "nft %s of denom %s has no spender", tokenID, denomID)
+	}
+
+	owner := nft.GetOwner()
+	if !owner.Equals(sender) && approval.Spender != sender.String() && !k.IsOperator(ctx, owner, sender, denomID) {
+		return types.NFT{}, types.NFTApproval{}, sdkerrors.Wrap(types.ErrUnauthorized, sender.String())
+	}
+
+	k.deleteNFTApproval(ctx, denomID, tokenID)
+	return nft.(types.NFT), approval, nil
+}
+
+// ApproveOperator allows the operator to transfer, sell or burn every nft of the owner in the denom,
+// or in every denom if the denomID is empty
+func (k Keeper) ApproveOperator(ctx sdk.Context, owner, operator sdk.AccAddress, denomID string) error {
+	if len(denomID) > 0 && !k.HasDenomID(ctx, denomID) {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+
+	k.SetOperator(ctx, types.OperatorApproval{Owner: owner.String(), Operator: operator.String(), DenomId: denomID})
+	return nil
+}
+
+// RevokeOperator removes an operator approval of the owner
+func (k Keeper) RevokeOperator(ctx sdk.Context, owner, operator sdk.AccAddress, denomID string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyOperator(owner, operator, denomID)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrUnknownApproval, "%s is not an operator of %s", operator, owner)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// AuthorizeSpender returns the nft if the sender is its owner, its approved spender or an operator of
+// the owner. Unlike Authorize, the returned nft may be owned by another address than the sender.
+func (k Keeper) AuthorizeSpender(ctx sdk.Context, denomID, tokenID string, sender sdk.AccAddress) (types.NFT, error) {
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.NFT{}, err
+	}
+
+	owner := nft.GetOwner()
+	if owner.Equals(sender) || k.IsOperator(ctx, owner, sender, denomID) {
+		return nft.(types.NFT), nil
+	}
+
+	if approval, found := k.GetNFTApproval(ctx, denomID, tokenID); found && approval.Spender == sender.String() {
+		return nft.(types.NFT), nil
+	}
+	return types.NFT{}, sdkerrors.Wrap(types.ErrUnauthorized, sender.String())
+}
+
+// IsOperator returns true if the operator is approved for the nfts of the owner in the denom or in every denom
+func (k Keeper) IsOperator(ctx sdk.Context, owner, operator sdk.AccAddress, denomID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyOperator(owner, operator, denomID)) || store.Has(types.KeyOperator(owner, operator, ""))
+}
+
+func (k Keeper) SetNFTApproval(ctx sdk.Context, approval types.NFTApproval) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&approval)
+	store.Set(types.KeyNFTApproval(approval.DenomId, approval.NftId), bz)
+}
+
+func (k Keeper) GetNFTApproval(ctx sdk.Context, denomID, tokenID string) (approval types.NFTApproval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNFTApproval(denomID, tokenID))
+	if bz == nil {
+		return approval, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &approval)
+	return approval, true
+}
+
+func (k Keeper) GetNFTApprovals(ctx sdk.Context) (approvals []types.NFTApproval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixNFTApproval)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.NFTApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
+
+// deleteNFTApproval removes the spender of an nft, it is called whenever the nft changes hands or is burned
+func (k Keeper) deleteNFTApproval(ctx sdk.Context, denomID, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyNFTApproval(denomID, tokenID))
+}
+
+func (k Keeper) SetOperator(ctx sdk.Context, approval types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&approval)
+	key := types.KeyOperator(sdk.MustAccAddressFromBech32(approval.Owner), sdk.MustAccAddressFromBech32(approval.Operator), approval.DenomId)
+	store.Set(key, bz)
+}
+
+func (k Keeper) GetOperators(ctx sdk.Context) (approvals []types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixOperator)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestApproveNFT() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	_, err := suite.keeper.ApproveNFT(suite.ctx, denomID, tokenID, address3, address4)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.ApproveNFT(suite.ctx, denomID, tokenID, address2, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.keeper.ApproveNFT(suite.ctx, denomID, tokenID, address3, address2)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address4, address4), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address3, address4))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address4, nft.GetOwner())
+
+	// the approval ends once the nft changes hands
+	_, found := suite.keeper.GetNFTApproval(suite.ctx, denomID, tokenID)
+	suite.False(found)
+	suite.Require().ErrorIs(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address3, address2), types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestRevokeNFTApproval() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	_, _, err := suite.keeper.RevokeNFTApproval(suite.ctx, denomID, tokenID, address2)
+	suite.Require().ErrorIs(err, types.ErrUnknownApproval)
+
+	_, err = suite.keeper.ApproveNFT(suite.ctx, denomID, tokenID, address3, address2)
+	suite.Require().NoError(err)
+	_, _, err = suite.keeper.RevokeNFTApproval(suite.ctx, denomID, tokenID, address4)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the spender can give up its approval
+	_, approval, err := suite.keeper.RevokeNFTApproval(suite.ctx, denomID, tokenID, address3)
+	suite.Require().NoError(err)
+	suite.Equal(address3.String(), approval.Spender)
+	suite.Require().ErrorIs(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address3, address4), types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestApproveOperator() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.mintNFT(denomID2, tokenID, "0", address2, address)
+
+	suite.Require().ErrorIs(suite.keeper.ApproveOperator(suite.ctx, address2, address3, "unknown"), types.ErrInvalidDenom)
+
+	// an operator of a denom cannot move the nfts of other denoms
+	suite.Require().NoError(suite.keeper.ApproveOperator(suite.ctx, address2, address3, denomID))
+	suite.True(suite.keeper.IsOperator(suite.ctx, address2, address3, denomID))
+	suite.False(suite.keeper.IsOperator(suite.ctx, address2, address3, denomID2))
+	suite.Require().ErrorIs(suite.keeper.TransferOwner(suite.ctx, denomID2, tokenID, address3, address4), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address3, address4))
+
+	// an operator of every denom can
+	suite.Require().NoError(suite.keeper.ApproveOperator(suite.ctx, address2, address3, ""))
+	suite.True(suite.keeper.IsOperator(suite.ctx, address2, address3, denomID2))
+	suite.Require().NoError(suite.keeper.BurnNFT(suite.ctx, denomID2, tokenID, address3))
+
+	suite.Require().NoError(suite.keeper.RevokeOperator(suite.ctx, address2, address3, ""))
+	suite.Require().ErrorIs(suite.keeper.RevokeOperator(suite.ctx, address2, address3, ""), types.ErrUnknownApproval)
+	suite.True(suite.keeper.IsOperator(suite.ctx, address2, address3, denomID))
+	suite.False(suite.keeper.IsOperator(suite.ctx, address2, address3, denomID2))
+}
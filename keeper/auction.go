@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// CreateAuction escrows an nft and opens an english auction that ends after the given duration. The sender
+// is the owner, its approved spender or an operator of the owner, and the owner is paid at settlement.
+func (k Keeper) CreateAuction(ctx sdk.Context, id, denomID string, startPrice sdk.Coin, duration time.Duration, sender sdk.AccAddress) (types.Auction, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomID)
+	}
+
+	if !k.HasNFT(ctx, denomID, id) {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", id, denomID)
+	}
+
+	nft, err := k.AuthorizeSpender(ctx, denomID, id, sender)
+	if err != nil {
+		return types.Auction{}, err
+	}
+	seller := nft.GetOwner()
+
+	if !nft.IsTransferable() {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
+	}
+
+	if err := k.validatePaymentDenom(ctx, startPrice.Denom); err != nil {
+		return types.Auction{}, err
+	}
+
+	if err := k.validateListingDuration(ctx, duration); err != nil {
+		return types.Auction{}, err
+	}
+
+	auction := types.NewAuction(id, denomID, startPrice.String(), seller, ctx.BlockTime(), ctx.BlockTime().Add(duration))
+
+	k.escrowNFT(ctx, denomID, nft, seller)
+	k.listOrder(ctx, types.NewMarketPlace(
+		id,
+		denomID,
+		auction.StartPrice,
+		types.EnglishAuction,
+		"", "",
+		seller,
+	))
+	k.SetAuction(ctx, auction)
+	k.InsertAuctionQueue(ctx, auction)
+	return auction, nil
+}
+
+// PlaceBid escrows the bid amount and refunds the previous highest bidder
+func (k Keeper) PlaceBid(ctx sdk.Context, id, denomID string, amount sdk.Coin, bidder sdk.AccAddress) error {
+	auction, err := k.GetAuction(ctx, denomID, id)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.BlockTime().Before(auction.EndTime) {
+		return sdkerrors.Wrapf(types.ErrAuctionClosed, "auction of nft %s ended at %s", id, auction.EndTime)
+	}
+
+	if bidder.Equals(auction.GetSeller()) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "seller cannot bid on own auction")
+	}
+
+	startPrice, err := sdk.ParseCoinNormalized(auction.StartPrice)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "unable to parse the start price %s", err.Error())
+	}
+
+	if amount.Denom != startPrice.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "bid denom %s does not match %s", amount.Denom, startPrice.Denom)
+	}
+
+	if auction.HasBid() {
+		highestBid, err := sdk.ParseCoinNormalized(auction.HighestBid)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidBid, "unable to parse the highest bid %s", err.Error())
+		}
+
+		if !highestBid.IsLT(amount) {
+			return sdkerrors.Wrapf(types.ErrInvalidBid, "bid %s must be higher than %s", amount, highestBid)
+		}
+	} else if amount.IsLT(startPrice) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "bid %s is lower than the start price %s", amount, startPrice)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.Coins{amount}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to escrow bid %s", err.Error())
+	}
+
+	if auction.HasBid() {
+		if err := k.refundBid(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	auction.HighestBid = amount.String()
+	auction.HighestBidder = bidder.String()
+	k.SetAuction(ctx, auction)
+	return nil
+}
+
+// CancelAuction closes an auction without bids and returns the nft to the seller. An auction that
+// left the settlement queue without being settled can be cancelled with bids, the highest bid is refunded.
+func (k Keeper) CancelAuction(ctx sdk.Context, id, denomID string, seller sdk.AccAddress) error {
+	auction, err := k.GetAuction(ctx, denomID, id)
+	if err != nil {
+		return err
+	}
+
+	if !seller.Equals(auction.GetSeller()) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is unathorized to cancel the auction", seller.String())
+	}
+
+	if auction.HasBid() && k.isAuctionQueued(ctx, auction) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "auction of nft %s already has bids", id)
+	}
+
+	return k.AbortAuction(ctx, auction)
+}
+
+// AbortAuction closes an auction without a sale, refunding the highest bid and returning the nft
+// to the seller. It is used when the settlement of an ended auction fails.
+func (k Keeper) AbortAuction(ctx sdk.Context, auction types.Auction) error {
+	if auction.HasBid() {
+		if err := k.refundBid(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	nft, err := k.GetNFT(ctx, auction.DenomId, auction.NftId)
+	if err != nil {
+		return err
+	}
+
+	k.releaseNFT(ctx, auction.DenomId, nft.(types.NFT), auction.GetSeller())
+	k.DeleteMarketPlaceNFT(ctx, auction.DenomId, auction.NftId)
+	k.deleteAuction(ctx, auction)
+	return nil
+}
+
+// SettleAuction transfers the nft to the highest bidder and pays the seller and creator royalties
+// from the escrowed bid. Auctions without bids return the nft to the seller.
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) error {
+	nft, err := k.GetNFT(ctx, auction.DenomId, auction.NftId)
+	if err != nil {
+		return err
+	}
+
+	seller := auction.GetSeller()
+	if !auction.HasBid() {
+		k.releaseNFT(ctx, auction.DenomId, nft.(types.NFT), seller)
+		k.DeleteMarketPlaceNFT(ctx, auction.DenomId, auction.NftId)
+		k.deleteAuction(ctx, auction)
+		return nil
+	}
+
+	price, err := sdk.ParseDecCoin(auction.HighestBid)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "unable to parse the highest bid %s", err.Error())
+	}
+
+	winner := auction.GetHighestBidder()
+	if err := k.distributeSale(ctx, k.GetEscrowAddress(), auction.DenomId, nft, seller, price); err != nil {
+		return err
+	}
+
+	k.releaseNFT(ctx, auction.DenomId, nft.(types.NFT), winner)
+
+	order, err := k.GetMarketPlaceNFT(ctx, auction.DenomId, auction.NftId)
+	if err == nil {
+		order1 := order.(types.MarketPlace)
+		order1.Price = auction.HighestBid
+		order1.Buyer = winner.String()
+		k.fillOrder(ctx, order1)
+	}
+
+	k.deleteAuction(ctx, auction)
+	return nil
+}
+
+func (k Keeper) refundBid(ctx sdk.Context, auction types.Auction) error {
+	bid, err := sdk.ParseCoinNormalized(auction.HighestBid)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "unable to parse the highest bid %s", err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.GetHighestBidder(), sdk.Coins{bid}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to refund bid %s", err.Error())
+	}
+	return nil
+}
+
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(types.KeyAuction(auction.DenomId, auction.NftId), bz)
+}
+
+func (k Keeper) GetAuction(ctx sdk.Context, denomID, id string) (types.Auction, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyAuction(denomID, id))
+	if bz == nil {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrUnknownAuction, "nft %s is not in an auction", id)
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, nil
+}
+
+// GetAuctions returns all the open auctions
+func (k Keeper) GetAuctions(ctx sdk.Context) (auctions []types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixAuction)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+func (k Keeper) deleteAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAuction(auction.DenomId, auction.NftId))
+	store.Delete(types.KeyAuctionQueue(auction.EndTime, auction.DenomId, auction.NftId))
+}
+
+// InsertAuctionQueue schedules the auction for settlement at its end time
+func (k Keeper) InsertAuctionQueue(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAuctionQueue(auction.EndTime, auction.DenomId, auction.NftId), []byte{})
+}
+
+// DequeueAuction removes an auction from the settlement queue, the auction is kept and can be cancelled by its seller
+func (k Keeper) DequeueAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAuctionQueue(auction.EndTime, auction.DenomId, auction.NftId))
+}
+
+func (k Keeper) isAuctionQueued(ctx sdk.Context, auction types.Auction) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyAuctionQueue(auction.EndTime, auction.DenomId, auction.NftId))
+}
+
+// IterateEndedAuctions iterates over the auctions that ended at or before the given time
+func (k Keeper) IterateEndedAuctions(ctx sdk.Context, endTime time.Time, cb func(auction types.Auction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PrefixAuctionQueue, sdk.PrefixEndBytes(types.KeyAuctionQueue(endTime, "", "")))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, denomID, tokenID, err := types.SplitKeyAuctionQueue(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		auction, err := k.GetAuction(ctx, denomID, tokenID)
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(auction) {
+			break
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestSettleAuction() {
+	suite.setFeeBps(250)
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	suite.fund(address4, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	auction, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address2)
+	suite.Require().NoError(err)
+
+	// the nft is escrowed while it is auctioned
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(suite.keeper.GetEscrowAddress(), nft.GetOwner())
+
+	err = suite.keeper.PlaceBid(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidBid)
+
+	suite.Require().NoError(suite.keeper.PlaceBid(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), address3))
+	suite.Equal(sdk.NewInt(900), suite.balance(address3))
+
+	// the outbid bidder is refunded
+	suite.Require().NoError(suite.keeper.PlaceBid(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), address4))
+	suite.Equal(sdk.NewInt(1000), suite.balance(address3))
+	suite.Equal(sdk.NewInt(800), suite.balance(address4))
+	suite.Equal(sdk.NewInt(200), suite.balance(suite.keeper.GetEscrowAddress()))
+
+	auction, err = suite.keeper.GetAuction(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(auction.EndTime)
+	var ended []types.Auction
+	suite.keeper.IterateEndedAuctions(suite.ctx, suite.ctx.BlockTime(), func(auction types.Auction) bool {
+		ended = append(ended, auction)
+		return false
+	})
+	suite.Require().Len(ended, 1)
+	suite.Require().NoError(suite.keeper.SettleAuction(suite.ctx, ended[0]))
+
+	// 2.5% fee to the fee pool, 10% royalty to the creator and the rest to the seller
+	suite.Equal(sdk.NewInt(5), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(20), suite.balance(address))
+	suite.Equal(sdk.NewInt(175), suite.balance(address2))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+
+	nft, err = suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address4, nft.GetOwner())
+	suite.False(nft.(types.NFT).Listed)
+
+	_, err = suite.keeper.GetAuction(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownAuction)
+	suite.Equal(uint64(5), suite.keeper.GetCollectedFees(suite.ctx, "").Amount.AmountOf(sdk.DefaultBondDenom).Uint64())
+}
+
+func (suite *KeeperSuite) TestSettleAuctionWithoutBids() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	auction, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address2)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(auction.EndTime)
+	suite.Require().NoError(suite.keeper.SettleAuction(suite.ctx, auction))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, nft.GetOwner())
+
+	_, err = suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownMarketPlace)
+}
+
+func (suite *KeeperSuite) TestEndBlockerAbortsFailedSettlement() {
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	auction, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address2)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.PlaceBid(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), address3))
+
+	// a fee above the price cannot be paid from the escrowed bid, so the settlement fails
+	suite.paramSpace.Set(suite.ctx, types.KeyMarketplaceFeeBps, uint32(20000))
+
+	suite.ctx = suite.ctx.WithBlockTime(auction.EndTime)
+	nft.EndBlocker(suite.ctx, suite.keeper)
+
+	// the bid is refunded and the nft returned instead of being stranded in escrow
+	suite.Equal(sdk.NewInt(1000), suite.balance(address3))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+	suite.True(suite.balance(address2).IsZero())
+
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, token.GetOwner())
+
+	_, err = suite.keeper.GetAuction(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownAuction)
+	suite.keeper.IterateEndedAuctions(suite.ctx, suite.ctx.BlockTime(), func(auction types.Auction) bool {
+		suite.Fail("auction left in the settlement queue")
+		return true
+	})
+}
+
+func (suite *KeeperSuite) TestCancelUnsettledAuction() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	auction, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address2)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.PlaceBid(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), address3))
+
+	// the seller cannot walk away from an ended auction waiting for settlement
+	suite.ctx = suite.ctx.WithBlockTime(auction.EndTime)
+	err = suite.keeper.CancelAuction(suite.ctx, tokenID, denomID, address2)
+	suite.Require().ErrorIs(err, types.ErrInvalidBid)
+
+	auction, err = suite.keeper.GetAuction(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.keeper.DequeueAuction(suite.ctx, auction)
+
+	suite.Require().NoError(suite.keeper.CancelAuction(suite.ctx, tokenID, denomID, address2))
+	suite.Equal(sdk.NewInt(1000), suite.balance(address3))
+
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, token.GetOwner())
+}
+
+func (suite *KeeperSuite) TestCreateAuctionByOperator() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	_, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.ApproveOperator(suite.ctx, address2, address3, denomID))
+	auction, err := suite.keeper.CreateAuction(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), time.Hour, address3)
+	suite.Require().NoError(err)
+
+	// the owner stays the seller and is paid at settlement
+	suite.Equal(address2.String(), auction.Seller)
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// exec executes the messages on behalf of their signers through the authz keeper
+func (suite *KeeperSuite) exec(grantee sdk.AccAddress, msgs ...sdk.Msg) error {
+	msg := authz.NewMsgExec(grantee, msgs)
+	_, err := suite.authzKeeper.Exec(sdk.WrapSDKContext(suite.ctx), &msg)
+	return err
+}
+
+func (suite *KeeperSuite) TestExecTransferNFTAuthorization() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+
+	transfer := &types.MsgTransferNFT{Id: tokenID, DenomId: denomID, Sender: address2.String(), Recipient: address4.String()}
+	suite.Require().ErrorIs(suite.exec(address3, transfer), authz.ErrNoAuthorizationFound)
+
+	authorization := types.NewTransferNFTAuthorization(nil, []types.NFTRef{{DenomId: denomID, NftId: tokenID}})
+	suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, address3, address2, authorization, nil))
+
+	suite.Require().NoError(suite.exec(address3, transfer))
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address4, nft.GetOwner())
+
+	// the grant only covers a single nft and is deleted once it is used
+	transfer = &types.MsgTransferNFT{Id: tokenID2, DenomId: denomID, Sender: address2.String(), Recipient: address4.String()}
+	suite.Require().ErrorIs(suite.exec(address3, transfer), authz.ErrNoAuthorizationFound)
+}
+
+func (suite *KeeperSuite) TestExecSellNFTAuthorization() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+
+	authorization := types.NewSellNFTAuthorization([]string{denomID}, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)))
+	suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, address3, address2, authorization, nil))
+
+	sell := &types.MsgSellNFT{Id: tokenID, DenomId: denomID, Price: "50stake", Seller: address2.String(), ListedType: types.Crypto}
+	suite.Require().ErrorIs(suite.exec(address3, sell), types.ErrInvalidPrice)
+
+	sell = &types.MsgSellNFT{Id: tokenID, DenomId: denomID, Price: "100stake", Seller: address2.String(), ListedType: types.Crypto}
+	suite.Require().NoError(suite.exec(address3, sell))
+	order, err := suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, order.GetSeller())
+
+	// the grant is kept for further listings
+	sell = &types.MsgSellNFT{Id: tokenID2, DenomId: denomID, Price: "200stake", Seller: address2.String(), ListedType: types.Crypto}
+	suite.Require().NoError(suite.exec(address3, sell))
+}
+
+func (suite *KeeperSuite) TestExecMintAuthorization() {
+	authorization := types.NewMintAuthorization([]string{denomID}, 2)
+	suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, address3, address, authorization, nil))
+
+	mint := &types.MsgMintNFT{Id: tokenID, DenomId: denomID2, Transferable: true, Creator: address.String()}
+	suite.Require().ErrorIs(suite.exec(address3, mint), types.ErrUnauthorized)
+
+	for _, id := range []string{tokenID, tokenID2} {
+		mint = &types.MsgMintNFT{Id: id, DenomId: denomID, Transferable: true, Creator: address.String(), Royalties: "0"}
+		suite.Require().NoError(suite.exec(address3, mint))
+		suite.True(suite.keeper.HasNFT(suite.ctx, denomID, id))
+	}
+
+	// the quota is used up
+	mint = &types.MsgMintNFT{Id: tokenID3, DenomId: denomID, Transferable: true, Creator: address.String()}
+	suite.Require().ErrorIs(suite.exec(address3, mint), authz.ErrNoAuthorizationFound)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// CreateBadgeDenom creates a denom of soulbound badges bound to the community. Only admins and the owner can create one.
+func (k Keeper) CreateBadgeDenom(ctx sdk.Context, id, name, symbol, description, previewURI, communityID, data string, creator sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, communityID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", communityID)
+	}
+
+	if community.Archived {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "community %s is archived", communityID)
+	}
+
+	if !k.GetCommunityRole(ctx, community, creator).CanManage() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot create badges in community %s", creator, communityID)
+	}
+
+	if err := k.chargeDenomCreationFee(ctx, creator); err != nil {
+		return err
+	}
+
+	denom := types.NewDenom(id, name, symbol, description, previewURI, creator.String(), communityID, nil, "", false, 0, 0, data, types.PaymentInfo{}, nil, types.TokenGate{})
+	denom.Badge = true
+	return k.SetDenom(ctx, denom)
+}
+
+// IssueBadge mints a non transferable badge to a member of the community of the badge denom.
+// Every admin of the community can issue badges, not only the creator of the denom.
+func (k Keeper) IssueBadge(ctx sdk.Context, denomID, tokenID string, metadata types.Metadata, data string, recipient, sender sdk.AccAddress) (types.Community, error) {
+	community, err := k.getBadgeCommunity(ctx, denomID, sender)
+	if err != nil {
+		return types.Community{}, err
+	}
+
+	if community.Archived {
+		return types.Community{}, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s is archived", community.Id)
+	}
+
+	if k.GetCommunityRole(ctx, community, recipient) == types.RoleNone {
+		return types.Community{}, sdkerrors.Wrapf(types.ErrMembership, "%s is not a member of community %s", recipient, community.Id)
+	}
+
+	if err := k.MintNFT(ctx, denomID, tokenID, sdk.ZeroDec().String(), false, recipient, sender, metadata, data, nil); err != nil {
+		return types.Community{}, err
+	}
+	return community, nil
+}
+
+// RevokeBadge burns a badge from its holder. The holder can burn its own badges with BurnNFT.
+func (k Keeper) RevokeBadge(ctx sdk.Context, denomID, tokenID string, sender sdk.AccAddress) (types.Community, sdk.AccAddress, error) {
+	community, err := k.getBadgeCommunity(ctx, denomID, sender)
+	if err != nil {
+		return types.Community{}, nil, err
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.Community{}, nil, err
+	}
+
+	holder := nft.GetOwner()
+	k.deleteNFT(ctx, denomID, nft)
+	k.deleteOwner(ctx, denomID, tokenID, holder)
+	k.decreaseSupply(ctx, denomID)
+	return community, holder, nil
+}
+
+// getBadgeCommunity returns the community of a badge denom if the sender can manage its badges
+func (k Keeper) getBadgeCommunity(ctx sdk.Context, denomID string, sender sdk.AccAddress) (types.Community, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.Community{}, err
+	}
+
+	if !denom.Badge {
+		return types.Community{}, sdkerrors.Wrapf(types.ErrInvalidBadge, "denom %s is not a badge denom", denomID)
+	}
+
+	community, found := k.GetCommunityByID(ctx, denom.CommunityId)
+	if !found {
+		return types.Community{}, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", denom.CommunityId)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanManage() {
+		return types.Community{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot manage the badges of community %s", sender, community.Id)
+	}
+	return community, nil
+}
+
+// GetCommunityBadges returns the badges issued by the community
+func (k Keeper) GetCommunityBadges(ctx sdk.Context, communityID string) (badges []types.Badge) {
+	for _, denom := range k.getCommunityDenoms(ctx, communityID) {
+		if !denom.Badge {
+			continue
+		}
+
+		for _, nft := range k.GetNFTs(ctx, denom.Id) {
+			badges = append(badges, types.Badge{CommunityId: communityID, DenomId: denom.Id, NFT: nft.(types.NFT)})
+		}
+	}
+	return badges
+}
+
+// GetBadgesByHolder returns the badges held by the address in every community
+func (k Keeper) GetBadgesByHolder(ctx sdk.Context, address sdk.AccAddress) (badges []types.Badge) {
+	for _, idc := range k.GetOwner(ctx, address, "").IDCollections {
+		denom, err := k.GetDenom(ctx, idc.DenomId)
+		if err != nil || !denom.Badge {
+			continue
+		}
+
+		for _, id := range idc.NftIds {
+			nft, err := k.GetNFT(ctx, denom.Id, id)
+			if err != nil {
+				continue
+			}
+			badges = append(badges, types.Badge{CommunityId: denom.CommunityId, DenomId: denom.Id, NFT: nft.(types.NFT)})
+		}
+	}
+	return badges
+}
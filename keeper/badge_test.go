@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// createBadgeDenom creates a badge denom of a community owned by address with address2 as a member
+func (suite *KeeperSuite) createBadgeDenom() types.Community {
+	community := suite.createCommunity("community")
+	err := suite.keeper.CreateBadgeDenom(suite.ctx, "badges", "badges", "badges", "", "", community.Id, "", address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.CreateBadgeDenom(suite.ctx, "badges", "badges", "badges", "", "", community.Id, "", address))
+	return community
+}
+
+func (suite *KeeperSuite) TestIssueBadge() {
+	community := suite.createBadgeDenom()
+
+	_, err := suite.keeper.IssueBadge(suite.ctx, "badges", tokenID, types.Metadata{Name: tokenNm}, "", address3, address)
+	suite.Require().ErrorIs(err, types.ErrMembership)
+	_, err = suite.keeper.IssueBadge(suite.ctx, "badges", tokenID, types.Metadata{Name: tokenNm}, "", address2, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.IssueBadge(suite.ctx, denomID, tokenID, types.Metadata{Name: tokenNm}, "", address2, address)
+	suite.Require().ErrorIs(err, types.ErrInvalidBadge)
+
+	// every admin can issue badges
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address4, types.RoleAdmin, address))
+	_, err = suite.keeper.IssueBadge(suite.ctx, "badges", tokenID, types.Metadata{Name: tokenNm}, "", address2, address4)
+	suite.Require().NoError(err)
+
+	badges := suite.keeper.GetBadgesByHolder(suite.ctx, address2)
+	suite.Require().Len(badges, 1)
+	suite.Equal(community.Id, badges[0].CommunityId)
+	suite.Equal(tokenID, badges[0].NFT.Id)
+	suite.Len(suite.keeper.GetCommunityBadges(suite.ctx, community.Id), 1)
+
+	// badges are only issued by admins, never minted
+	err = suite.mintAs("badges", tokenID2, address)
+	suite.Require().ErrorIs(err, types.ErrInvalidBadge)
+}
+
+func (suite *KeeperSuite) TestBadgeIsSoulbound() {
+	suite.createBadgeDenom()
+	_, err := suite.keeper.IssueBadge(suite.ctx, "badges", tokenID, types.Metadata{Name: tokenNm}, "", address2, address)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, "badges", tokenID, address2, address3)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownRequest)
+
+	// the holder can still give up its badge
+	suite.Require().NoError(suite.keeper.BurnNFT(suite.ctx, "badges", tokenID, address2))
+	suite.Empty(suite.keeper.GetBadgesByHolder(suite.ctx, address2))
+	suite.checkSupply()
+}
+
+func (suite *KeeperSuite) TestRevokeBadge() {
+	suite.createBadgeDenom()
+	_, err := suite.keeper.IssueBadge(suite.ctx, "badges", tokenID, types.Metadata{Name: tokenNm}, "", address2, address)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.keeper.RevokeBadge(suite.ctx, "badges", tokenID, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, holder, err := suite.keeper.RevokeBadge(suite.ctx, "badges", tokenID, address)
+	suite.Require().NoError(err)
+	suite.Equal(address2, holder)
+	suite.False(suite.keeper.HasNFT(suite.ctx, "badges", tokenID))
+	suite.Empty(suite.keeper.GetBadgesByHolder(suite.ctx, address2))
+	suite.checkSupply()
+}
@@ -19,6 +19,7 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 			nft.GetCreator(),
 			nft.GetMetadata(),
 			nft.GetAttributes(),
+			nft.GetRoyaltyShares(),
 		); err != nil {
 			return err
 		}
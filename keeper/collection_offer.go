@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// MakeCollectionOffer escrows price * quantity for an offer on any nft of a denom
+func (k Keeper) MakeCollectionOffer(ctx sdk.Context, denomID string, price sdk.Coin, quantity uint64, expiresAt *time.Time, bidder sdk.AccAddress) (types.CollectionOffer, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomID)
+	}
+
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "offer expiry %s is in the past", expiresAt)
+	}
+
+	if err := k.validatePaymentDenom(ctx, price.Denom); err != nil {
+		return types.CollectionOffer{}, err
+	}
+
+	total := sdk.NewCoin(price.Denom, price.Amount.Mul(sdk.NewIntFromUint64(quantity)))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.Coins{total}); err != nil {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to escrow offer %s", err.Error())
+	}
+
+	offer := types.NewCollectionOffer(k.nextCollectionOfferID(ctx), denomID, price.String(), quantity, expiresAt, bidder)
+	k.SetCollectionOffer(ctx, offer)
+	return offer, nil
+}
+
+// CancelCollectionOffer withdraws a collection offer and refunds the unfilled amount
+func (k Keeper) CancelCollectionOffer(ctx sdk.Context, offerID uint64, bidder sdk.AccAddress) (types.CollectionOffer, error) {
+	offer, err := k.GetCollectionOffer(ctx, offerID)
+	if err != nil {
+		return types.CollectionOffer{}, err
+	}
+
+	if !bidder.Equals(offer.GetBidder()) {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is unathorized to cancel the offer", bidder.String())
+	}
+
+	if err := k.refundCollectionOffer(ctx, offer); err != nil {
+		return types.CollectionOffer{}, err
+	}
+
+	k.deleteCollectionOffer(ctx, offer)
+	return offer, nil
+}
+
+// AcceptCollectionOffer sells one nft of the offer's denom to the bidder, paying the creator royalty
+// and the owner from the escrowed offer. The offer is removed once its quantity is filled. The sender
+// is the owner, its approved spender or an operator of the owner.
+func (k Keeper) AcceptCollectionOffer(ctx sdk.Context, offerID uint64, id string, sender sdk.AccAddress) (types.CollectionOffer, error) {
+	offer, err := k.GetCollectionOffer(ctx, offerID)
+	if err != nil {
+		return types.CollectionOffer{}, err
+	}
+
+	if offer.IsExpired(ctx.BlockTime()) {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "collection offer %d has expired", offerID)
+	}
+
+	nft, err := k.AuthorizeSpender(ctx, offer.DenomId, id, sender)
+	if err != nil {
+		return types.CollectionOffer{}, err
+	}
+	owner := nft.GetOwner()
+
+	bidder := offer.GetBidder()
+	if bidder.Equals(owner) {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "bidder cannot accept own offer")
+	}
+
+	if !nft.IsTransferable() {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
+	}
+
+	if nft.Listed {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrListedNFT, "nft %s is listed in market place", id)
+	}
+
+	price, err := sdk.ParseDecCoin(offer.Price)
+	if err != nil {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "unable to parse the offer price %s", err.Error())
+	}
+
+	if err := k.distributeSale(ctx, k.GetEscrowAddress(), offer.DenomId, nft, owner, price); err != nil {
+		return types.CollectionOffer{}, err
+	}
+
+	nft.Owner = bidder.String()
+	k.SetNFT(ctx, offer.DenomId, nft)
+	k.swapOwner(ctx, offer.DenomId, id, owner, bidder)
+
+	offer.Filled = offer.Filled + 1
+	if offer.Remaining() == 0 {
+		k.deleteCollectionOffer(ctx, offer)
+	} else {
+		k.SetCollectionOffer(ctx, offer)
+	}
+	return offer, nil
+}
+
+// ExpireCollectionOffer refunds and removes a collection offer whose expiry has passed
+func (k Keeper) ExpireCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) error {
+	if err := k.refundCollectionOffer(ctx, offer); err != nil {
+		return err
+	}
+
+	k.deleteCollectionOffer(ctx, offer)
+	return nil
+}
+
+func (k Keeper) refundCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) error {
+	price, err := sdk.ParseCoinNormalized(offer.Price)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidOffer, "unable to parse the offer price %s", err.Error())
+	}
+
+	refund := sdk.NewCoin(price.Denom, price.Amount.Mul(sdk.NewIntFromUint64(offer.Remaining())))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, offer.GetBidder(), sdk.Coins{refund}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to refund offer %s", err.Error())
+	}
+	return nil
+}
+
+// SetCollectionOffer stores a collection offer along with its price index and expiry queue entry
+func (k Keeper) SetCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+
+	price, err := sdk.ParseCoinNormalized(offer.Price)
+	if err != nil {
+		panic(err)
+	}
+
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.KeyCollectionOffer(offer.Id), bz)
+	store.Set(types.KeyCollectionOfferPrice(offer.DenomId, price.Denom, price.Amount, offer.Id), sdk.Uint64ToBigEndian(offer.Id))
+	if offer.ExpiresAt != nil {
+		store.Set(types.KeyCollectionOfferQueue(*offer.ExpiresAt, offer.Id), sdk.Uint64ToBigEndian(offer.Id))
+	}
+}
+
+func (k Keeper) GetCollectionOffer(ctx sdk.Context, offerID uint64) (types.CollectionOffer, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyCollectionOffer(offerID))
+	if bz == nil {
+		return types.CollectionOffer{}, sdkerrors.Wrapf(types.ErrUnknownOffer, "collection offer %d does not exist", offerID)
+	}
+
+	var offer types.CollectionOffer
+	k.cdc.MustUnmarshal(bz, &offer)
+	return offer, nil
+}
+
+// GetCollectionOffers returns all the open collection offers
+func (k Keeper) GetCollectionOffers(ctx sdk.Context) (offers []types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixCollectionOffer)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.CollectionOffer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+func (k Keeper) deleteCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+
+	price, err := sdk.ParseCoinNormalized(offer.Price)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Delete(types.KeyCollectionOffer(offer.Id))
+	store.Delete(types.KeyCollectionOfferPrice(offer.DenomId, price.Denom, price.Amount, offer.Id))
+	if offer.ExpiresAt != nil {
+		store.Delete(types.KeyCollectionOfferQueue(*offer.ExpiresAt, offer.Id))
+	}
+}
+
+func (k Keeper) nextCollectionOfferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := uint64(1)
+	if bz := store.Get(types.KeyNextCollectionOfferID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.KeyNextCollectionOfferID, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// SetNextCollectionOfferID sets the id assigned to the next collection offer
+func (k Keeper) SetNextCollectionOfferID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextCollectionOfferID, sdk.Uint64ToBigEndian(id))
+}
+
+// DequeueCollectionOffer removes a collection offer from the expiry queue, the offer is kept and can be cancelled by its bidder
+func (k Keeper) DequeueCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) {
+	if offer.ExpiresAt == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCollectionOfferQueue(*offer.ExpiresAt, offer.Id))
+}
+
+// IterateExpiredCollectionOffers iterates over the collection offers that expired at or before the given time
+func (k Keeper) IterateExpiredCollectionOffers(ctx sdk.Context, expiresAt time.Time, cb func(offer types.CollectionOffer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PrefixCollectionOfferQueue, sdk.PrefixEndBytes(types.KeyCollectionOfferQueue(expiresAt, 0)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		offer, err := k.GetCollectionOffer(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(offer) {
+			break
+		}
+	}
+}
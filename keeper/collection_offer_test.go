@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestCollectionOfferPartialFill() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.mintNFT(denomID, tokenID2, "0", address4, address)
+	suite.mintNFT(denomID2, tokenID3, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)))
+
+	offer, err := suite.keeper.MakeCollectionOffer(suite.ctx, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), 3, nil, address3)
+	suite.Require().NoError(err)
+	suite.True(suite.balance(address3).IsZero())
+
+	// only nfts of the offer's denom can fill it
+	_, err = suite.keeper.AcceptCollectionOffer(suite.ctx, offer.Id, tokenID3, address2)
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.AcceptCollectionOffer(suite.ctx, offer.Id, tokenID, address2)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.AcceptCollectionOffer(suite.ctx, offer.Id, tokenID2, address4)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt(50), suite.balance(address2))
+	suite.Equal(sdk.NewInt(50), suite.balance(address4))
+	suite.Equal(uint64(2), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address3))
+
+	offer, err = suite.keeper.GetCollectionOffer(suite.ctx, offer.Id)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), offer.Remaining())
+
+	// cancelling refunds the unfilled quantity only
+	_, err = suite.keeper.CancelCollectionOffer(suite.ctx, offer.Id, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.CancelCollectionOffer(suite.ctx, offer.Id, address3)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt(50), suite.balance(address3))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+	_, err = suite.keeper.GetCollectionOffer(suite.ctx, offer.Id)
+	suite.Require().ErrorIs(err, types.ErrUnknownOffer)
+}
+
+func (suite *KeeperSuite) TestCollectionOfferFilled() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+
+	offer, err := suite.keeper.MakeCollectionOffer(suite.ctx, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), 1, nil, address3)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.AcceptCollectionOffer(suite.ctx, offer.Id, tokenID, address2)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.GetCollectionOffer(suite.ctx, offer.Id)
+	suite.Require().ErrorIs(err, types.ErrUnknownOffer)
+	suite.Empty(suite.keeper.GetCollectionOffers(suite.ctx))
+}
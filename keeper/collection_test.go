@@ -1,74 +1,57 @@
 package keeper_test
 
 import (
-	"github.com/AutonomyNetwork/nft/keeper"
 	"github.com/AutonomyNetwork/nft/types"
 )
 
 func (suite *KeeperSuite) TestSetCollection() {
-	nft := types.NewBaseNFT(tokenID, tokenNm, address, tokenURI, tokenData)
+	metadata := types.Metadata{Name: tokenNm, Description: tokenURI}
+	nft := types.NewBaseNFT(tokenID, metadata, address, true, "0", address, blockTime, tokenData)
 	// create a new NFT and add it to the collection created with the NFT mint
-	nft2 := types.NewBaseNFT(tokenID2, tokenNm, address, tokenURI, tokenData)
+	nft2 := types.NewBaseNFT(tokenID2, metadata, address, true, "0", address, blockTime, tokenData)
 	
-	denomE := types.Denom{
-		Id:      denomID,
-		Name:    denomNm,
-		Schema:  schema,
-		Creator: address,
-	}
+	denomE, err := suite.keeper.GetDenom(suite.ctx, denomID)
+	suite.NoError(err)
 	
 	collection2 := types.Collection{
 		Denom: denomE,
-		NFTs:  []types.BaseNFT{nft2, nft},
+		NFTs:  []types.NFT{nft2, nft},
 	}
 	
-	err := suite.keeper.SetCollection(suite.ctx, collection2)
+	err = suite.keeper.SetCollection(suite.ctx, collection2)
 	suite.Nil(err)
 	
 	collection2, err = suite.keeper.GetCollection(suite.ctx, denomID)
 	suite.NoError(err)
 	suite.Len(collection2.NFTs, 2)
 	
-	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
-	suite.False(fail, msg)
+	suite.checkSupply()
 }
 
 func (suite *KeeperSuite) TestGetCollection() {
-	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
-	suite.NoError(err)
+	suite.mintNFT(denomID, tokenID, "0", address, address)
 	
 	// collection should exist
 	collection, err := suite.keeper.GetCollection(suite.ctx, denomID)
 	suite.NoError(err)
 	suite.NotEmpty(collection)
 	
-	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
-	suite.False(fail, msg)
+	suite.checkSupply()
 }
 
 func (suite *KeeperSuite) TestGetCollections() {
+	suite.mintNFT(denomID, tokenID, "0", address, address)
 	
-	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
-	suite.NoError(err)
+	collections := suite.keeper.GetCollections(suite.ctx)
+	suite.Len(collections, 3)
 	
-	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
-	suite.False(fail, msg)
+	suite.checkSupply()
 }
 
 func (suite *KeeperSuite) TestGetSupply() {
-	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
-	suite.NoError(err)
-	
-	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address2)
-	suite.NoError(err)
-	
-	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm2, tokenURI, tokenData, address2)
-	suite.NoError(err)
+	suite.mintNFT(denomID, tokenID, "0", address, address)
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+	suite.mintNFT(denomID2, tokenID, "0", address2, address)
 	
 	supply := suite.keeper.GetTotalSupply(suite.ctx, denomID)
 	suite.Equal(uint64(2), supply)
@@ -89,7 +72,7 @@ func (suite *KeeperSuite) TestGetSupply() {
 	suite.Equal(uint64(1), supply)
 	
 	// burn nft
-	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address)
+	err := suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address)
 	suite.NoError(err)
 	
 	supply = suite.keeper.GetTotalSupply(suite.ctx, denomID)
@@ -3,7 +3,6 @@ package keeper
 import (
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	
 	"github.com/AutonomyNetwork/nft/types"
 )
@@ -52,44 +51,12 @@ func (k Keeper) GetCommunities(ctx sdk.Context) (communities []types.Community)
 	return communities
 }
 
-// Check collection creator is a community creator
+// Check collection creator has a community role that can create denoms
 func (k Keeper) AuthorizedCommunityMember(ctx sdk.Context, community_id string, creator sdk.AccAddress) bool {
 	community, found := k.GetCommunityByID(ctx, community_id)
 	if !found {
 		return false
 	}
 	
-	addr, err := sdk.AccAddressFromBech32(community.Creator)
-	if err != nil {
-		return false
-	}
-	
-	if !addr.Equals(creator) {
-		return false
-	}
-	
-	return true
-}
-
-func (k Keeper) GetCommunityMembers(ctx sdk.Context, community_id string) (types.CommunityMembers, error) {
-	store := ctx.KVStore(k.storeKey)
-	
-	if !k.HasCommunity(ctx, community_id) {
-		return types.CommunityMembers{}, sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", community_id)
-	}
-	
-	data := store.Get(types.KeyCommunityMembers(community_id))
-	if data == nil {
-		return types.CommunityMembers{}, nil
-	}
-	
-	var cm types.CommunityMembers
-	k.cdc.MustUnmarshal(data, &cm)
-	return cm, nil
-}
-
-func (k Keeper) SetCommunityMembers(ctx sdk.Context, community_member types.CommunityMembers) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyCommunityMembers(community_member.CommunityId), k.cdc.MustMarshal(&community_member))
-	
+	return k.GetCommunityRole(ctx, community, creator).CanCreateDenom()
 }
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// GetCommunityRole returns the role of the address in the community. The creator of the
+// community is its owner and is not stored as a member.
+func (k Keeper) GetCommunityRole(ctx sdk.Context, community types.Community, address sdk.AccAddress) types.CommunityRole {
+	if strings.EqualFold(community.Creator, address.String()) {
+		return types.RoleOwner
+	}
+
+	if member, found := k.getCommunityMember(ctx, community.Id, address); found {
+		return member.Role
+	}
+	return types.RoleNone
+}
+
+// GrantCommunityRole gives the address a role in the community, adding it to the members if needed
+func (k Keeper) GrantCommunityRole(ctx sdk.Context, id string, address sdk.AccAddress, role types.CommunityRole, sender sdk.AccAddress) error {
+	community, ok := k.GetCommunityByID(ctx, id)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	senderRole := k.GetCommunityRole(ctx, community, sender)
+	current := k.GetCommunityRole(ctx, community, address)
+	if !senderRole.CanGrant(role) || !senderRole.CanGrant(current) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot grant %s to %s in community %s", sender, role, address, id)
+	}
+
+	k.SetCommunityMember(ctx, types.CommunityMember{CommunityId: id, Address: address.String(), Role: role})
+	return nil
+}
+
+// RevokeCommunityRole turns an address with a role back into a plain member of the community
+func (k Keeper) RevokeCommunityRole(ctx sdk.Context, id string, address, sender sdk.AccAddress) error {
+	community, ok := k.GetCommunityByID(ctx, id)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	member, found := k.getCommunityMember(ctx, id, address)
+	if !found || member.Role <= types.RoleMember {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s has no role in community %s", address, id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanGrant(member.Role) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot revoke %s from %s in community %s", sender, member.Role, address, id)
+	}
+
+	member.Role = types.RoleMember
+	k.SetCommunityMember(ctx, member)
+	return nil
+}
+
+// GetCommunityMemberRoles returns the owner and every member of the community with their roles
+func (k Keeper) GetCommunityMemberRoles(ctx sdk.Context, community types.Community) []types.CommunityMember {
+	members := []types.CommunityMember{{CommunityId: community.Id, Address: community.Creator, Role: types.RoleOwner}}
+	return append(members, k.iterateCommunityMembers(ctx, types.KeyCommunityMember(community.Id, nil))...)
+}
+
+// GetAllCommunityMembers returns the members of every community with their roles, without the owners
+func (k Keeper) GetAllCommunityMembers(ctx sdk.Context) []types.CommunityMember {
+	return k.iterateCommunityMembers(ctx, types.PrefixCommunityMember)
+}
+
+// SetCommunityMember stores the member of the community with its role and indexes the community by the member
+func (k Keeper) SetCommunityMember(ctx sdk.Context, member types.CommunityMember) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&member)
+	store.Set(types.KeyCommunityMember(member.CommunityId, sdk.MustAccAddressFromBech32(member.Address)), bz)
+	store.Set(types.KeyMemberCommunity(member.Address, member.CommunityId), []byte{})
+}
+
+func (k Keeper) isCommunityMember(ctx sdk.Context, id string, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyCommunityMember(id, address))
+}
+
+func (k Keeper) addCommunityMember(ctx sdk.Context, id string, address sdk.AccAddress) {
+	k.SetCommunityMember(ctx, types.CommunityMember{CommunityId: id, Address: address.String(), Role: types.RoleMember})
+}
+
+func (k Keeper) getCommunityMember(ctx sdk.Context, id string, address sdk.AccAddress) (types.CommunityMember, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyCommunityMember(id, address))
+	if bz == nil {
+		return types.CommunityMember{}, false
+	}
+
+	var member types.CommunityMember
+	k.cdc.MustUnmarshal(bz, &member)
+	return member, true
+}
+
+func (k Keeper) deleteCommunityMember(ctx sdk.Context, id string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCommunityMember(id, address))
+	store.Delete(types.KeyMemberCommunity(address.String(), id))
+}
+
+func (k Keeper) deleteCommunityMembers(ctx sdk.Context, id string) {
+	for _, member := range k.iterateCommunityMembers(ctx, types.KeyCommunityMember(id, nil)) {
+		k.deleteCommunityMember(ctx, id, sdk.MustAccAddressFromBech32(member.Address))
+	}
+}
+
+func (k Keeper) iterateCommunityMembers(ctx sdk.Context, prefix []byte) (members []types.CommunityMember) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.CommunityMember
+		k.cdc.MustUnmarshal(iterator.Value(), &member)
+		members = append(members, member)
+	}
+	return members
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestGrantCommunityRole() {
+	community := suite.createCommunity("community")
+
+	// only the owner manages admins
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address2, types.RoleAdmin, address))
+	err := suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address3, types.RoleAdmin, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address3, types.RoleModerator, address2))
+	suite.Equal(types.RoleModerator, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+
+	// moderators cannot grant roles
+	err = suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address4, types.RoleCreator, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	err = suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address, types.RoleMember, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().ErrorIs(suite.keeper.RevokeCommunityRole(suite.ctx, community.Id, address2, address3), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.RevokeCommunityRole(suite.ctx, community.Id, address3, address2))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+	suite.Require().ErrorIs(suite.keeper.RevokeCommunityRole(suite.ctx, community.Id, address3, address2), types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.RevokeCommunityRole(suite.ctx, community.Id, address2, address))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address2))
+}
+
+func (suite *KeeperSuite) TestCommunityRoleCreateDenom() {
+	community := suite.createCommunity("community")
+	msg := &types.MsgCreateDenom{Id: "roledenom", Name: "roledenom", Symbol: "roledenom", Creator: address2.String(), CommunityId: community.Id}
+
+	// plain members cannot create denoms in the community
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address2, types.RoleCreator, address))
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, "roledenom")
+	suite.Require().NoError(err)
+	suite.Equal(community.Id, denom.CommunityId)
+}
+
+func (suite *KeeperSuite) TestCommunityRoleUpdateCommunity() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address2, types.RoleModerator, address))
+
+	err := suite.keeper.UpdateCommunity(suite.ctx, "description", "data", community.Id, nil, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address2, types.RoleAdmin, address))
+	suite.Require().NoError(suite.keeper.UpdateCommunity(suite.ctx, "description", "data", community.Id, nil, address2))
+
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, community.Id)
+	suite.Require().True(found)
+	suite.Equal("description", community.Description)
+}
+
+func (suite *KeeperSuite) TestCommunityMembersPagination() {
+	community := suite.createCommunity("community")
+	suite.createCommunity("community2")
+	for _, member := range []sdk.AccAddress{address3, address4} {
+		_, err := suite.keeper.JoinCommunity(suite.ctx, community.Id, member, "")
+		suite.Require().NoError(err)
+	}
+
+	// the owner is not stored as a member
+	res, err := suite.keeper.CommunityMembers(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunityMembersRequest{CommunityId: community.Id})
+	suite.Require().NoError(err)
+	suite.ElementsMatch([]string{address2.String(), address3.String(), address4.String()}, res.Members.Addresses)
+
+	res, err = suite.keeper.CommunityMembers(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunityMembersRequest{
+		CommunityId: community.Id,
+		Pagination:  &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Len(res.Members.Addresses, 2)
+	suite.NotEmpty(res.Pagination.NextKey)
+
+	res, err = suite.keeper.CommunityMembers(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunityMembersRequest{
+		CommunityId: community.Id,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Len(res.Members.Addresses, 1)
+}
+
+func (suite *KeeperSuite) TestCommunitiesByMember() {
+	suite.createCommunity("community")
+	suite.createCommunity("community2")
+
+	res, err := suite.keeper.CommunitiesByMember(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunitiesByMemberRequest{Address: address2.String()})
+	suite.Require().NoError(err)
+	suite.Len(res.Communities, 2)
+
+	suite.Require().NoError(suite.keeper.LeaveCommunity(suite.ctx, "community", address2))
+	res, err = suite.keeper.CommunitiesByMember(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunitiesByMemberRequest{Address: address2.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Communities, 1)
+	suite.Equal("community2", res.Communities[0].Id)
+
+	_, err = suite.keeper.CommunitiesByMember(sdk.WrapSDKContext(suite.ctx), &types.QueryCommunitiesByMemberRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// createCommunity creates an open community owned by address with address2 as a member
+func (suite *KeeperSuite) createCommunity(id string) types.Community {
+	_, err := suite.msgServer.CreateCommunity(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateCommunity{Id: id, Name: id, Creator: address.String()})
+	suite.Require().NoError(err)
+	suite.keeper.SetCommunityMember(suite.ctx, types.CommunityMember{CommunityId: id, Address: address2.String(), Role: types.RoleMember})
+
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, id)
+	suite.Require().True(found)
+	return community
+}
+
+func (suite *KeeperSuite) TestCreateExistingCommunity() {
+	suite.createCommunity("community")
+
+	_, err := suite.msgServer.CreateCommunity(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateCommunity{Id: "community", Name: "community", Creator: address3.String()})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, "community")
+	suite.Require().True(found)
+	suite.Equal(address.String(), community.Creator)
+}
+
+func (suite *KeeperSuite) TestDeleteCommunity() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.CreateCommunityInvite(suite.ctx, community.Id, []byte("code"), 1, address))
+	id, err := suite.keeper.SubmitCommunityProposal(suite.ctx, types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalText}, address2)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.DeleteCommunity(suite.ctx, community.Id, address2, false)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// funds left in the treasury could not be spent anymore
+	suite.fund(types.CommunityTreasuryAddress(community.Id), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, err = suite.keeper.DeleteCommunity(suite.ctx, community.Id, address, false)
+	suite.Require().ErrorIs(err, types.ErrTreasury)
+
+	suite.Require().NoError(suite.keeper.CommunitySpend(suite.ctx, community.Id, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), address))
+	archived, err := suite.keeper.DeleteCommunity(suite.ctx, community.Id, address, false)
+	suite.Require().NoError(err)
+	suite.False(archived)
+
+	suite.False(suite.keeper.HasCommunity(suite.ctx, community.Id))
+	suite.Empty(suite.keeper.GetAllCommunityMembers(suite.ctx))
+	suite.Empty(suite.keeper.GetCommunityInvites(suite.ctx))
+	_, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.False(found)
+	suite.False(suite.ctx.KVStore(suite.storeKey).Has(types.KeyMemberCommunity(address2.String(), community.Id)))
+}
+
+func (suite *KeeperSuite) TestArchiveCommunity() {
+	community := suite.createCommunity("community")
+	err := suite.keeper.CreateDenom(suite.ctx, "communitydenom", "communitydenom", "communitydenom", "", "", address.String(), community.Id,
+		nil, "", false, 0, 0, "", types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+
+	// a community with denoms is only archived
+	_, err = suite.keeper.DeleteCommunity(suite.ctx, community.Id, address, false)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	archived, err := suite.keeper.DeleteCommunity(suite.ctx, community.Id, address, true)
+	suite.Require().NoError(err)
+	suite.True(archived)
+
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, community.Id)
+	suite.Require().True(found)
+	suite.True(community.Archived)
+	suite.True(suite.keeper.HasDenomID(suite.ctx, "communitydenom"))
+}
+
+func (suite *KeeperSuite) TestTransferCommunityOwnership() {
+	community := suite.createCommunity("community")
+
+	err := suite.keeper.TransferCommunityOwnership(suite.ctx, community.Id, address2, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.TransferCommunityOwnership(suite.ctx, community.Id, address2, address))
+
+	// the previous owner stays as a member and the new owner is not stored as one
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, community.Id)
+	suite.Require().True(found)
+	suite.Equal(address2.String(), community.Creator)
+	suite.Equal(types.RoleOwner, suite.keeper.GetCommunityRole(suite.ctx, community, address2))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address))
+
+	members := suite.keeper.GetAllCommunityMembers(suite.ctx)
+	suite.Require().Len(members, 1)
+	suite.Equal(address.String(), members[0].Address)
+}
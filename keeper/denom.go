@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -40,10 +38,21 @@ func (k Keeper) SetDenom(ctx sdk.Context, denom types.Denom) error {
 	if len(denom.Name) > 0 {
 		store.Set(types.KeyDenomName(denom.Name), []byte(denom.Id))
 	}
+	store.Set(types.KeyDenomCreator(denom.Creator, denom.Id), []byte{})
+	if len(denom.CommunityId) > 0 {
+		store.Set(types.KeyCommunityDenom(denom.CommunityId, denom.Id), []byte{})
+	}
 
 	return nil
 }
 
+// updateDenom overwrites the definition of an existing denomID
+func (k Keeper) updateDenom(ctx sdk.Context, denom types.Denom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.KeyDenomID(denom.Id), bz)
+}
+
 // SetDenom is responsible for saving the definition of denomID
 func (k Keeper) GetDenom(ctx sdk.Context, id string) (denom types.Denom, err error) {
 	store := ctx.KVStore(k.storeKey)
@@ -71,19 +80,33 @@ func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []types.Denom) {
 	return denoms
 }
 
+// GetDenomsByCreator returns the denoms owned by the address using the creator index
 func (k Keeper) GetDenomsByCreator(ctx sdk.Context, address string) ([]types.Denom, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyDenomCreator(address, "")
 
-	denoms := k.GetDenoms(ctx)
-	if len(denoms) == 0 {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "unable to find collection")
-	}
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
 
 	var data []types.Denom
-	for _, denom := range denoms {
-		if strings.EqualFold(address, denom.Creator) {
-			data = append(data, denom)
+	for ; iterator.Valid(); iterator.Next() {
+		denom, err := k.GetDenom(ctx, string(iterator.Key()[len(prefix):]))
+		if err != nil {
+			return nil, err
 		}
+		data = append(data, denom)
 	}
 
 	return data, nil
 }
+
+// setDenomCreator moves the denom in the creator index from its current creator to the new creator
+func (k Keeper) setDenomCreator(ctx sdk.Context, denom types.Denom, creator string) types.Denom {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyDenomCreator(denom.Creator, denom.Id))
+	store.Set(types.KeyDenomCreator(creator, denom.Id), []byte{})
+
+	denom.Creator = creator
+	k.updateDenom(ctx, denom)
+	return denom
+}
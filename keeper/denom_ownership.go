@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// TransferDenomOwnership proposes the recipient as the new owner of a denom of the sender
+func (k Keeper) TransferDenomOwnership(ctx sdk.Context, denomID string, recipient, sender sdk.AccAddress) error {
+	if _, err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+
+	k.SetDenomTransfer(ctx, types.DenomOwnershipTransfer{
+		DenomId:   denomID,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+	})
+	return nil
+}
+
+// AcceptDenomOwnership makes the sender the owner of a denom proposed to it. The new owner
+// takes over the update and mint rights of the denom and the royalties paid to the old owner.
+func (k Keeper) AcceptDenomOwnership(ctx sdk.Context, denomID string, sender sdk.AccAddress) (types.DenomOwnershipTransfer, error) {
+	transfer, found := k.GetDenomTransfer(ctx, denomID)
+	if !found {
+		return transfer, sdkerrors.Wrapf(types.ErrUnknownTransfer, "denom %s has no pending ownership transfer", denomID)
+	}
+
+	if transfer.Recipient != sender.String() {
+		return transfer, sdkerrors.Wrapf(types.ErrUnauthorized, "denom %s is not being transferred to %s", denomID, sender)
+	}
+
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return transfer, err
+	}
+
+	if denom.Creator != transfer.Sender {
+		return transfer, sdkerrors.Wrapf(types.ErrUnauthorized, "%s no longer owns denom %s", transfer.Sender, denomID)
+	}
+
+	denom.RoyaltyShares, _ = types.ReplaceRoyaltyRecipient(denom.RoyaltyShares, transfer.Sender, transfer.Recipient)
+	k.setDenomCreator(ctx, denom, transfer.Recipient)
+
+	for _, token := range k.GetNFTs(ctx, denomID) {
+		nft := token.(types.NFT)
+		shares, changed := types.ReplaceRoyaltyRecipient(nft.RoyaltyShares, transfer.Sender, transfer.Recipient)
+		if changed {
+			nft.RoyaltyShares = shares
+			k.SetNFT(ctx, denomID, nft)
+		}
+	}
+
+	// the owner no longer needs a minter grant
+	k.deleteMinter(ctx, denomID, sender)
+	k.deleteDenomTransfer(ctx, denomID)
+	return transfer, nil
+}
+
+func (k Keeper) SetDenomTransfer(ctx sdk.Context, transfer types.DenomOwnershipTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&transfer)
+	store.Set(types.KeyDenomTransfer(transfer.DenomId), bz)
+}
+
+func (k Keeper) GetDenomTransfer(ctx sdk.Context, denomID string) (types.DenomOwnershipTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyDenomTransfer(denomID))
+	if bz == nil {
+		return types.DenomOwnershipTransfer{}, false
+	}
+
+	var transfer types.DenomOwnershipTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+func (k Keeper) deleteDenomTransfer(ctx sdk.Context, denomID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyDenomTransfer(denomID))
+}
+
+// GetDenomTransfers returns every pending denom ownership transfer
+func (k Keeper) GetDenomTransfers(ctx sdk.Context) (transfers []types.DenomOwnershipTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixDenomTransfer)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.DenomOwnershipTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestTransferDenomOwnership() {
+	suite.mintNFT(denomID, tokenID, "0.1", address3, address)
+	suite.Require().NoError(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 1, nil, address))
+
+	suite.Require().ErrorIs(suite.keeper.TransferDenomOwnership(suite.ctx, denomID, address2, address3), types.ErrUnauthorized)
+	_, err := suite.keeper.AcceptDenomOwnership(suite.ctx, denomID, address2)
+	suite.Require().ErrorIs(err, types.ErrUnknownTransfer)
+
+	// the ownership only moves once the recipient accepts it
+	suite.Require().NoError(suite.keeper.TransferDenomOwnership(suite.ctx, denomID, address2, address))
+	denom, err := suite.keeper.GetDenom(suite.ctx, denomID)
+	suite.Require().NoError(err)
+	suite.Equal(address.String(), denom.Creator)
+
+	_, err = suite.keeper.AcceptDenomOwnership(suite.ctx, denomID, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.AcceptDenomOwnership(suite.ctx, denomID, address2)
+	suite.Require().NoError(err)
+
+	denom, err = suite.keeper.GetDenom(suite.ctx, denomID)
+	suite.Require().NoError(err)
+	suite.Equal(address2.String(), denom.Creator)
+	_, found := suite.keeper.GetDenomTransfer(suite.ctx, denomID)
+	suite.False(found)
+
+	// the denom moves to the creator index of its new owner
+	denoms, err := suite.keeper.GetDenomsByCreator(suite.ctx, address.String())
+	suite.Require().NoError(err)
+	suite.Len(denoms, 2)
+	denoms, err = suite.keeper.GetDenomsByCreator(suite.ctx, address2.String())
+	suite.Require().NoError(err)
+	suite.Require().Len(denoms, 1)
+	suite.Equal(denomID, denoms[0].Id)
+
+	// the new owner takes over the royalties and no longer needs its minter grant
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Len(nft.(types.NFT).RoyaltyShares, 1)
+	suite.Equal(address2.String(), nft.(types.NFT).RoyaltyShares[0].Address)
+	_, found = suite.keeper.GetMinter(suite.ctx, denomID, address2)
+	suite.False(found)
+
+	suite.Require().ErrorIs(suite.keeper.TransferDenomOwnership(suite.ctx, denomID, address3, address), types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestAcceptStaleDenomOwnership() {
+	suite.Require().NoError(suite.keeper.TransferDenomOwnership(suite.ctx, denomID, address2, address))
+	suite.Require().NoError(suite.keeper.TransferDenomOwnership(suite.ctx, denomID, address3, address))
+
+	// a new proposal replaces the pending one
+	_, err := suite.keeper.AcceptDenomOwnership(suite.ctx, denomID, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.AcceptDenomOwnership(suite.ctx, denomID, address3)
+	suite.Require().NoError(err)
+}
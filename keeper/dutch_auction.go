@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// CreateDutchAuction escrows an nft and lists it at a price that decays from the start price to the floor price.
+// The sender is the owner, its approved spender or an operator of the owner, and the owner is paid on purchase.
+func (k Keeper) CreateDutchAuction(ctx sdk.Context, id, denomID, startPrice, floorPrice, decayAmount string, decayInterval time.Duration, sender sdk.AccAddress) (types.DutchAuction, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return types.DutchAuction{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomID)
+	}
+
+	if !k.HasNFT(ctx, denomID, id) {
+		return types.DutchAuction{}, sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", id, denomID)
+	}
+
+	nft, err := k.AuthorizeSpender(ctx, denomID, id, sender)
+	if err != nil {
+		return types.DutchAuction{}, err
+	}
+	seller := nft.GetOwner()
+
+	if !nft.IsTransferable() {
+		return types.DutchAuction{}, sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
+	}
+
+	price, err := sdk.ParseCoinNormalized(startPrice)
+	if err != nil {
+		return types.DutchAuction{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "unable to parse the start price %s", err.Error())
+	}
+
+	if err := k.validatePaymentDenom(ctx, price.Denom); err != nil {
+		return types.DutchAuction{}, err
+	}
+
+	auction := types.NewDutchAuction(id, denomID, startPrice, floorPrice, decayAmount, decayInterval, seller, ctx.BlockTime())
+
+	k.escrowNFT(ctx, denomID, nft, seller)
+	k.listOrder(ctx, types.NewMarketPlace(
+		id,
+		denomID,
+		startPrice,
+		types.DescendingAuction,
+		"", "",
+		seller,
+	))
+	k.SetDutchAuction(ctx, auction)
+	return auction, nil
+}
+
+// GetDutchAuctionPrice returns the price of a dutch auction at the current block time
+func (k Keeper) GetDutchAuctionPrice(ctx sdk.Context, denomID, id string) (sdk.Coin, error) {
+	auction, err := k.GetDutchAuction(ctx, denomID, id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	price, err := auction.CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "unable to compute dutch auction price %s", err.Error())
+	}
+	return price, nil
+}
+
+func (k Keeper) SetDutchAuction(ctx sdk.Context, auction types.DutchAuction) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(types.KeyDutchAuction(auction.DenomId, auction.NftId), bz)
+}
+
+func (k Keeper) GetDutchAuction(ctx sdk.Context, denomID, id string) (types.DutchAuction, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyDutchAuction(denomID, id))
+	if bz == nil {
+		return types.DutchAuction{}, sdkerrors.Wrapf(types.ErrUnknownAuction, "nft %s is not in a dutch auction", id)
+	}
+
+	var auction types.DutchAuction
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, nil
+}
+
+// GetDutchAuctions returns all the open dutch auctions
+func (k Keeper) GetDutchAuctions(ctx sdk.Context) (auctions []types.DutchAuction) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixDutchAuction)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.DutchAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+func (k Keeper) deleteDutchAuction(ctx sdk.Context, denomID, id string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyDutchAuction(denomID, id))
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperSuite) TestDutchAuctionPrice() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	_, err := suite.keeper.CreateDutchAuction(suite.ctx, tokenID, denomID, "100stake", "40stake", "25stake", time.Hour, address2)
+	suite.Require().NoError(err)
+
+	price, err := suite.keeper.GetDutchAuctionPrice(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), price)
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(2*time.Hour + time.Minute))
+	price, err = suite.keeper.GetDutchAuctionPrice(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), price)
+
+	// the price never decays below the floor
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(10 * time.Hour))
+	price, err = suite.keeper.GetDutchAuctionPrice(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40), price)
+}
+
+func (suite *KeeperSuite) TestBuyDutchAuction() {
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	_, err := suite.keeper.CreateDutchAuction(suite.ctx, tokenID, denomID, "100stake", "40stake", "25stake", time.Hour, address2)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// the buyer pays the price at the block time, split between royalty and seller
+	suite.Equal(sdk.NewInt(925), suite.balance(address3))
+	suite.Equal(sdk.NewInt(7), suite.balance(address))
+	suite.Equal(sdk.NewInt(68), suite.balance(address2))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+
+	_, err = suite.keeper.GetDutchAuction(suite.ctx, denomID, tokenID)
+	suite.Require().Error(err)
+}
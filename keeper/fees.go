@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// GetFeePoolAddress returns the module account that collects the marketplace fees
+func (k Keeper) GetFeePoolAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.FeePoolName)
+}
+
+// GetFeeBps returns the marketplace fee charged on sales of a denom and the community
+// that receives it. An empty community means the fee goes to the fee pool.
+func (k Keeper) GetFeeBps(ctx sdk.Context, denomID string) (uint32, string) {
+	params := k.GetParams(ctx)
+
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return params.MarketplaceFeeBps, ""
+	}
+
+	feeBps, ok := params.GetFeeBps(denom.CommunityId)
+	if !ok {
+		return feeBps, ""
+	}
+	return feeBps, denom.CommunityId
+}
+
+// payMarketplaceFee sends the marketplace fee of a sale from the payer to the fee pool or to the
+// community treasury and returns the amount paid
+func (k Keeper) payMarketplaceFee(ctx sdk.Context, payer sdk.AccAddress, denomID, tokenID string, price sdk.DecCoin) (sdk.Coin, error) {
+	feeBps, communityID := k.GetFeeBps(ctx, denomID)
+
+	fee := sdk.NewCoin(price.Denom, price.Amount.MulInt64(int64(feeBps)).QuoInt64(types.MaxFeeBps).TruncateInt())
+	if !fee.IsPositive() {
+		return fee, nil
+	}
+
+	var (
+		recipient sdk.AccAddress
+		err       error
+	)
+	switch {
+	case len(communityID) > 0:
+		recipient = types.CommunityTreasuryAddress(communityID)
+		err = k.bankKeeper.SendCoins(ctx, payer, recipient, sdk.Coins{fee})
+	case payer.Equals(k.GetEscrowAddress()):
+		recipient = k.GetFeePoolAddress()
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.FeePoolName, sdk.Coins{fee})
+	default:
+		recipient = k.GetFeePoolAddress()
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.FeePoolName, sdk.Coins{fee})
+	}
+	if err != nil {
+		return fee, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer marketplace fee %s", err.Error())
+	}
+
+	collected := k.GetCollectedFees(ctx, communityID)
+	collected.Amount = collected.Amount.Add(fee)
+	k.SetCollectedFees(ctx, collected)
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventMarketplaceFee{
+			Id:        tokenID,
+			DenomId:   denomID,
+			Amount:    fee.String(),
+			Recipient: recipient.String(),
+		},
+	)
+	return fee, nil
+}
+
+// GetCollectedFees returns the fees collected by a community, or by the fee pool when communityID is empty
+func (k Keeper) GetCollectedFees(ctx sdk.Context, communityID string) types.CollectedFees {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyCollectedFees(communityID))
+	if bz == nil {
+		return types.CollectedFees{CommunityId: communityID, Amount: sdk.Coins{}}
+	}
+
+	var fees types.CollectedFees
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+func (k Keeper) SetCollectedFees(ctx sdk.Context, fees types.CollectedFees) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&fees)
+	store.Set(types.KeyCollectedFees(fees.CommunityId), bz)
+}
+
+// GetAllCollectedFees returns the fees collected by the fee pool and every community
+func (k Keeper) GetAllCollectedFees(ctx sdk.Context) (fees []types.CollectedFees) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixCollectedFees)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var collected types.CollectedFees
+		k.cdc.MustUnmarshal(iterator.Value(), &collected)
+		fees = append(fees, collected)
+	}
+	return fees
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestMarketplaceFee() {
+	suite.setFeeBps(250)
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// 2.5% fee to the fee pool, 10% royalty to the creator and the rest to the seller
+	suite.True(suite.balance(address3).IsZero())
+	suite.Equal(sdk.NewInt(25), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(100), suite.balance(address))
+	suite.Equal(sdk.NewInt(875), suite.balance(address2))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+}
+
+func (suite *KeeperSuite) TestRoyaltyCappedByFee() {
+	suite.setFeeBps(9000)
+	suite.mintNFT(denomID, tokenID, "0.5", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "100stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// the royalty only gets what is left after the fee and the buyer never pays more than the price
+	suite.True(suite.balance(address3).IsZero())
+	suite.Equal(sdk.NewInt(90), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(10), suite.balance(address))
+	suite.True(suite.balance(address2).IsZero())
+}
+
+func (suite *KeeperSuite) TestCommunityFeeOverride() {
+	community := types.Community{Id: "community", Name: "community", Creator: address.String()}
+	suite.Require().NoError(suite.keeper.SetCommunity(suite.ctx, community))
+	err := suite.keeper.CreateDenom(suite.ctx, "communitydenom", "communitydenom", "communitydenom", "", "", address.String(), community.Id,
+		nil, "", false, 0, 0, "", types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MarketplaceFeeBps = 100
+	params.CommunityFees = []types.CommunityFee{{CommunityId: community.Id, FeeBps: 500}}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.mintNFT("communitydenom", tokenID, "0", address2, address)
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, "communitydenom", "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, "communitydenom", address3))
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// the community fee goes to its treasury, other denoms pay the protocol fee to the fee pool
+	suite.Equal(sdk.NewInt(50), suite.balance(types.CommunityTreasuryAddress(community.Id)))
+	suite.Equal(sdk.NewInt(10), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(1940), suite.balance(address2))
+
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), suite.keeper.GetCollectedFees(suite.ctx, community.Id).Amount)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), suite.keeper.GetCollectedFees(suite.ctx, "").Amount)
+}
@@ -64,20 +64,16 @@ func (k Keeper) DenomsByOwner(c context.Context, request *types.QueryDenomsByOwn
 
 	store := ctx.KVStore(k.storeKey)
 
-	denomStore := prefix.NewStore(store, types.PrefixDenom)
+	denomStore := prefix.NewStore(store, types.KeyDenomCreator(request.Address, ""))
 
 	var ownerDenoms []types.Denom
-	pageRes, err = query.FilteredPaginate(denomStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var denom types.Denom
-		k.cdc.MustUnmarshal(value, &denom)
-		if denom.Creator != request.Address {
-			return false, nil
-		}
-		if accumulate && denom.Creator == request.Address {
-			ownerDenoms = append(ownerDenoms, denom)
+	pageRes, err = query.Paginate(denomStore, request.Pagination, func(key []byte, value []byte) error {
+		denom, err := k.GetDenom(ctx, string(key))
+		if err != nil {
+			return err
 		}
-
-		return true, nil
+		ownerDenoms = append(ownerDenoms, denom)
+		return nil
 	})
 
 	if err != nil {
@@ -268,12 +264,28 @@ func (k Keeper) CommunityMembers(c context.Context, request *types.QueryCommunit
 		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community id: %s", request.CommunityId)
 	}
 
-	cm, err := k.GetCommunityMembers(ctx, request.CommunityId)
-	if err != nil {
+	if !k.HasCommunity(ctx, request.CommunityId) {
 		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community id: %s", request.CommunityId)
 	}
 
-	return &types.QueryCommunityMembersResponse{Members: &cm}, nil
+	store := ctx.KVStore(k.storeKey)
+	memberStore := prefix.NewStore(store, types.KeyCommunityMember(request.CommunityId, nil))
+
+	cm := types.CommunityMembers{CommunityId: request.CommunityId}
+	pageRes, err := query.Paginate(memberStore, request.Pagination, func(key []byte, value []byte) error {
+		var member types.CommunityMember
+		k.cdc.MustUnmarshal(value, &member)
+		cm.Addresses = append(cm.Addresses, member.Address)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community members query %s", err.Error())
+	}
+
+	return &types.QueryCommunityMembersResponse{
+		Members:    &cm,
+		Pagination: pageRes,
+	}, nil
 }
 
 func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequest) (*types.QueryCollectionResponse, error) {
@@ -436,3 +448,468 @@ func (k Keeper) DenomIDsByOwner(c context.Context, request *types.QueryDenomIDsB
 func (k Keeper) AllListedNFTs(c context.Context, request *types.QueryAllListedNFTsRequest) (*types.QueryAllListedNFTsResponse, error) {
 	return &types.QueryAllListedNFTsResponse{}, nil
 }
+
+func (k Keeper) Auction(c context.Context, request *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	denomID := strings.ToLower(strings.TrimSpace(request.DenomId))
+	nftID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, err := k.GetAuction(ctx, denomID, nftID)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, nftID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid NFT %s from collection %s", request.Id, request.DenomId)
+	}
+
+	NFT, ok := nft.(types.NFT)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid type NFT %s from collection %s", request.Id, request.DenomId)
+	}
+
+	return &types.QueryAuctionResponse{
+		Auction: &auction,
+		NFT:     &NFT,
+	}, nil
+}
+
+func (k Keeper) Auctions(c context.Context, request *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+	var auctions []types.Auction
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	auctionStore := prefix.NewStore(store, types.PrefixAuction)
+
+	pageRes, err := query.Paginate(auctionStore, request.Pagination, func(key []byte, value []byte) error {
+		var auction types.Auction
+		k.cdc.MustUnmarshal(value, &auction)
+		auctions = append(auctions, auction)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAuction, "invalid auction query %s", err.Error())
+	}
+
+	return &types.QueryAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) DutchAuction(c context.Context, request *types.QueryDutchAuctionRequest) (*types.QueryDutchAuctionResponse, error) {
+	denomID := strings.ToLower(strings.TrimSpace(request.DenomId))
+	nftID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, err := k.GetDutchAuction(ctx, denomID, nftID)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := k.GetDutchAuctionPrice(ctx, denomID, nftID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDutchAuctionResponse{
+		Auction:      &auction,
+		CurrentPrice: price.String(),
+	}, nil
+}
+
+func (k Keeper) OffersByNFT(c context.Context, request *types.QueryOffersByNFTRequest) (*types.QueryOffersByNFTResponse, error) {
+	denomID := strings.ToLower(strings.TrimSpace(request.DenomId))
+	nftID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var offers []types.Offer
+	store := ctx.KVStore(k.storeKey)
+	offerStore := prefix.NewStore(store, types.KeyOffer(denomID, nftID, ""))
+
+	pageRes, err := query.Paginate(offerStore, request.Pagination, func(key []byte, value []byte) error {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(value, &offer)
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownOffer, "invalid offer query %s", err.Error())
+	}
+
+	return &types.QueryOffersByNFTResponse{
+		Offers:     offers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) OffersByBidder(c context.Context, request *types.QueryOffersByBidderRequest) (*types.QueryOffersByBidderResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(request.Bidder)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var offers []types.Offer
+	store := ctx.KVStore(k.storeKey)
+	bidderStore := prefix.NewStore(store, types.KeyOfferBidder(bidder, "", ""))
+
+	pageRes, err := query.Paginate(bidderStore, request.Pagination, func(key []byte, value []byte) error {
+		_, denomID, tokenID, err := types.SplitKeyOfferBidder(append(types.KeyOfferBidder(bidder, "", ""), key...))
+		if err != nil {
+			return err
+		}
+
+		offer, err := k.GetOffer(ctx, denomID, tokenID, bidder)
+		if err != nil {
+			return err
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownOffer, "invalid offer query %s", err.Error())
+	}
+
+	return &types.QueryOffersByBidderResponse{
+		Offers:     offers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) OffersByOwner(c context.Context, request *types.QueryOffersByOwnerRequest) (*types.QueryOffersByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var offers []types.Offer
+	for _, collection := range k.GetOwnerNFTs(ctx, owner) {
+		for _, nft := range collection.Nfts {
+			offers = append(offers, k.GetOffersByNFT(ctx, collection.Denom.Id, nft.Id)...)
+		}
+	}
+
+	return &types.QueryOffersByOwnerResponse{
+		Offers: offers,
+	}, nil
+}
+
+func (k Keeper) CollectionOffer(c context.Context, request *types.QueryCollectionOfferRequest) (*types.QueryCollectionOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offer, err := k.GetCollectionOffer(ctx, request.OfferId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCollectionOfferResponse{
+		Offer: &offer,
+	}, nil
+}
+
+// CollectionOffers returns the collection offers of a denom with the highest price first
+func (k Keeper) CollectionOffers(c context.Context, request *types.QueryCollectionOffersRequest) (*types.QueryCollectionOffersResponse, error) {
+	denomID := strings.ToLower(strings.TrimSpace(request.DenomId))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pagination := request.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	pagination.Reverse = true
+
+	var offers []types.CollectionOffer
+	store := ctx.KVStore(k.storeKey)
+	priceStore := prefix.NewStore(store, types.KeyCollectionOfferPrice(denomID, "", sdk.ZeroInt(), 0))
+
+	pageRes, err := query.Paginate(priceStore, pagination, func(key []byte, value []byte) error {
+		offer, err := k.GetCollectionOffer(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownOffer, "invalid offer query %s", err.Error())
+	}
+
+	return &types.QueryCollectionOffersResponse{
+		Offers:     offers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) FeePool(c context.Context, request *types.QueryFeePoolRequest) (*types.QueryFeePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeePoolResponse{
+		Collected: k.GetCollectedFees(ctx, "").Amount,
+		Balance:   k.bankKeeper.GetAllBalances(ctx, k.GetFeePoolAddress()),
+		FeeBps:    k.MarketplaceFeeBps(ctx),
+	}, nil
+}
+
+func (k Keeper) CommunityFees(c context.Context, request *types.QueryCommunityFeesRequest) (*types.QueryCommunityFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, request.CommunityId) {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", request.CommunityId)
+	}
+
+	feeBps, _ := k.GetParams(ctx).GetFeeBps(request.CommunityId)
+	return &types.QueryCommunityFeesResponse{
+		Collected: k.GetCollectedFees(ctx, request.CommunityId).Amount,
+		Treasury:  types.CommunityTreasuryAddress(request.CommunityId).String(),
+		FeeBps:    feeBps,
+	}, nil
+}
+
+func (k Keeper) Params(c context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) MintPhases(c context.Context, request *types.QueryMintPhasesRequest) (*types.QueryMintPhasesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDenomID(ctx, request.DenomId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", request.DenomId)
+	}
+
+	return &types.QueryMintPhasesResponse{Phases: k.GetMintPhases(ctx, request.DenomId)}, nil
+}
+
+func (k Keeper) MintEligibility(c context.Context, request *types.QueryMintEligibilityRequest) (*types.QueryMintEligibilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", err.Error())
+	}
+
+	phase, err := k.GetMintPhase(ctx, request.DenomId, request.PhaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintEligibilityResponse{
+		Allowlisted: !phase.AllowlistOnly || k.IsAllowlisted(ctx, request.DenomId, request.PhaseId, address),
+		Minted:      k.GetWalletMints(ctx, request.DenomId, request.PhaseId, address),
+		WalletLimit: phase.WalletLimit,
+	}, nil
+}
+
+func (k Keeper) Minters(c context.Context, request *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDenomID(ctx, request.DenomId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", request.DenomId)
+	}
+
+	return &types.QueryMintersResponse{Minters: k.GetMinters(ctx, request.DenomId)}, nil
+}
+
+func (k Keeper) CommunityRoles(c context.Context, request *types.QueryCommunityRolesRequest) (*types.QueryCommunityRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	community, found := k.GetCommunityByID(ctx, request.CommunityId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community id: %s", request.CommunityId)
+	}
+
+	return &types.QueryCommunityRolesResponse{Members: k.GetCommunityMemberRoles(ctx, community)}, nil
+}
+
+func (k Keeper) JoinRequests(c context.Context, request *types.QueryJoinRequestsRequest) (*types.QueryJoinRequestsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, request.CommunityId) {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community id: %s", request.CommunityId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	requestStore := prefix.NewStore(store, types.KeyJoinRequest(request.CommunityId, nil))
+
+	var requests []types.JoinRequest
+	pageRes, err := query.Paginate(requestStore, request.Pagination, func(key []byte, value []byte) error {
+		var joinRequest types.JoinRequest
+		k.cdc.MustUnmarshal(value, &joinRequest)
+		requests = append(requests, joinRequest)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrMembership, "invalid join request query %s", err.Error())
+	}
+
+	return &types.QueryJoinRequestsResponse{
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) CommunitiesByMember(c context.Context, request *types.QueryCommunitiesByMemberRequest) (*types.QueryCommunitiesByMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", request.Address)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	communityStore := prefix.NewStore(store, types.KeyMemberCommunity(request.Address, ""))
+
+	var communities []types.Community
+	pageRes, err := query.Paginate(communityStore, request.Pagination, func(key []byte, value []byte) error {
+		community, found := k.GetCommunityByID(ctx, string(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", string(key))
+		}
+		communities = append(communities, community)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "invalid community query %s", err.Error())
+	}
+
+	return &types.QueryCommunitiesByMemberResponse{
+		Communities: communities,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) CommunityTreasury(c context.Context, request *types.QueryCommunityTreasuryRequest) (*types.QueryCommunityTreasuryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	community, found := k.GetCommunityByID(ctx, request.CommunityId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", request.CommunityId)
+	}
+
+	treasury := types.CommunityTreasuryAddress(community.Id)
+	return &types.QueryCommunityTreasuryResponse{
+		Address: treasury.String(),
+		Balance: k.bankKeeper.GetAllBalances(ctx, treasury),
+		CutBps:  community.TreasuryCutBps,
+		Flow:    k.GetTreasuryFlow(ctx, community.Id),
+		Fees:    k.GetCollectedFees(ctx, community.Id).Amount,
+	}, nil
+}
+
+func (k Keeper) CommunityProposals(c context.Context, request *types.QueryCommunityProposalsRequest) (*types.QueryCommunityProposalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, request.CommunityId) {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", request.CommunityId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	proposalStore := prefix.NewStore(store, types.KeyCommunityProposal(request.CommunityId, 0))
+
+	var proposals []types.CommunityProposal
+	pageRes, err := query.Paginate(proposalStore, request.Pagination, func(key []byte, value []byte) error {
+		proposal, found := k.GetProposal(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknownProposal, "proposal %d not exists", sdk.BigEndianToUint64(key))
+		}
+		proposals = append(proposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "invalid proposal query %s", err.Error())
+	}
+
+	return &types.QueryCommunityProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) CommunityProposal(c context.Context, request *types.QueryCommunityProposalRequest) (*types.QueryCommunityProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetProposal(ctx, request.ProposalId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "proposal %d not exists", request.ProposalId)
+	}
+
+	tally := proposal.FinalTally
+	if community, found := k.GetCommunityByID(ctx, proposal.CommunityId); found && proposal.Status == types.StatusVoting {
+		tally = k.TallyCommunityProposal(ctx, community, proposal)
+	}
+
+	return &types.QueryCommunityProposalResponse{
+		Proposal: proposal,
+		Tally:    tally,
+		Votes:    k.GetVotes(ctx, proposal.Id),
+	}, nil
+}
+
+func (k Keeper) CommunityBadges(c context.Context, request *types.QueryCommunityBadgesRequest) (*types.QueryCommunityBadgesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, request.CommunityId) {
+		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "community doesn't exist :%s", request.CommunityId)
+	}
+
+	return &types.QueryCommunityBadgesResponse{
+		Badges: k.GetCommunityBadges(ctx, request.CommunityId),
+	}, nil
+}
+
+func (k Keeper) BadgesByHolder(c context.Context, request *types.QueryBadgesByHolderRequest) (*types.QueryBadgesByHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address %s", request.Address)
+	}
+
+	return &types.QueryBadgesByHolderResponse{
+		Badges: k.GetBadgesByHolder(ctx, address),
+	}, nil
+}
+
+func (k Keeper) NFTApproval(c context.Context, request *types.QueryNFTApprovalRequest) (*types.QueryNFTApprovalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasNFT(ctx, request.DenomId, request.Id) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", request.Id, request.DenomId)
+	}
+
+	approval, _ := k.GetNFTApproval(ctx, request.DenomId, request.Id)
+	return &types.QueryNFTApprovalResponse{Approval: approval}, nil
+}
+
+func (k Keeper) OperatorApprovals(c context.Context, request *types.QueryOperatorApprovalsRequest) (*types.QueryOperatorApprovalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	operatorStore := prefix.NewStore(store, types.KeyOperator(owner, nil, ""))
+
+	var approvals []types.OperatorApproval
+	pageRes, err := query.Paginate(operatorStore, request.Pagination, func(key []byte, value []byte) error {
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshal(value, &approval)
+		approvals = append(approvals, approval)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownApproval, "invalid operator approvals query %s", err.Error())
+	}
+
+	return &types.QueryOperatorApprovalsResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
@@ -3,11 +3,13 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/AutonomyNetwork/nft/types"
@@ -19,32 +21,51 @@ type Keeper struct {
 	cdc           codec.BinaryCodec
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	paramSpace    paramtypes.Subspace
+
+	// authority is the address allowed to update the module parameters, usually the gov module account
+	authority string
 }
 
 // NewKeeper creates new instances of the nft Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, paramSpace paramtypes.Subspace, authority string) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		paramSpace:    paramSpace,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("AutonomyNetwork/%s", types.ModuleName))
 }
 
 func (k Keeper) CreateDenom(ctx sdk.Context, id, name, symbol, description, previewURI string,
-	creator string, community_id string, depedent_collections []string, category string, onDemandMinting bool, totoalNFTs, availableNFTs int64, data string, paymentInfo types.PaymentInfo) error {
-	return k.SetDenom(ctx, types.NewDenom(id, name, symbol, description, previewURI, creator, community_id, depedent_collections, category, onDemandMinting, totoalNFTs, availableNFTs, data, paymentInfo))
+	creator string, community_id string, depedent_collections []string, category string, onDemandMinting bool, totoalNFTs, availableNFTs int64, data string, paymentInfo types.PaymentInfo, royaltyShares []types.RoyaltyShare, tokenGate types.TokenGate) error {
+	if err := k.validateRoyalty(ctx, types.TotalRoyaltyShares(royaltyShares)); err != nil {
+		return err
+	}
+	return k.SetDenom(ctx, types.NewDenom(id, name, symbol, description, previewURI, creator, community_id, depedent_collections, category, onDemandMinting, totoalNFTs, availableNFTs, data, paymentInfo, royaltyShares, tokenGate))
 }
 
 // MintNFT mints an NFT and manages that NFTs existence within Collections and Owners
 func (k Keeper) MintNFT(ctx sdk.Context,
 	denomID, nftID, royalties string, transferable bool,
-	owner, creator sdk.AccAddress, metadata types.Metadata, attributes string) error {
+	owner, creator sdk.AccAddress, metadata types.Metadata, attributes string, royaltyShares []types.RoyaltyShare) error {
 	if !k.HasDenomID(ctx, denomID) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
@@ -53,24 +74,40 @@ func (k Keeper) MintNFT(ctx sdk.Context,
 		return sdkerrors.Wrapf(types.ErrNFTAlreadyExists, "NFT %s already exists in collection %s", nftID, denomID)
 	}
 
-	decValue, err := sdk.NewDecFromStr(royalties)
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to parse the royalities %s", err.Error())
+	// a single royalty is paid entirely to the creator
+	if len(royaltyShares) == 0 {
+		decValue, err := sdk.NewDecFromStr(royalties)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to parse the royalities %s", err.Error())
+		}
+
+		if err := k.validateRoyalty(ctx, decValue); err != nil {
+			return err
+		}
+
+		if decValue.IsPositive() {
+			royaltyShares = []types.RoyaltyShare{types.NewRoyaltyShare(creator, decValue)}
+		}
 	}
 
-	if !(decValue.GTE(sdk.ZeroDec()) && decValue.LTE(sdk.OneDec())) {
-		return sdkerrors.Wrapf(types.ErrInvalidNFT, "royalities in between 0 to 1; given :%s", decValue.String())
+	total := types.TotalRoyaltyShares(royaltyShares)
+	if err := k.validateRoyalty(ctx, total); err != nil {
+		return err
 	}
-	k.SetNFT(ctx, denomID, types.NewBaseNFT(
+
+	nft := types.NewBaseNFT(
 		nftID,
 		metadata,
 		owner,
 		transferable,
-		royalties,
+		total.String(),
 		creator,
 		ctx.BlockTime(),
 		attributes,
-	))
+	)
+	nft.RoyaltyShares = royaltyShares
+
+	k.SetNFT(ctx, denomID, nft)
 	k.setOwner(ctx, denomID, nftID, owner)
 	k.increaseSupply(ctx, denomID)
 	return nil
@@ -89,6 +126,10 @@ func (k Keeper) UpdateNFT(ctx sdk.Context,
 		return err
 	}
 
+	if nft.Listed {
+		return sdkerrors.Wrapf(types.ErrListedNFT, "nft %s is listed in market place", nft.Id)
+	}
+
 	if name != "[do-not-modify]" {
 		nft.Metadata.Name = name
 	}
@@ -98,22 +139,40 @@ func (k Keeper) UpdateNFT(ctx sdk.Context,
 	}
 
 	if royalties != "[do-not-modify]" {
+		// a single royalty cannot describe how a split between several recipients should change
+		if len(k.GetRoyaltyShares(ctx, denomID, nft)) > 1 {
+			return sdkerrors.Wrapf(types.ErrInvalidRoyalty, "nft %s splits its royalty between several recipients", nft.Id)
+		}
+
+		decValue, err := sdk.NewDecFromStr(royalties)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to parse the royalities %s", err.Error())
+		}
+
+		if err := k.validateRoyalty(ctx, decValue); err != nil {
+			return err
+		}
 		nft.Royalties = royalties
+
+		nft.RoyaltyShares = nil
+		if decValue.IsPositive() {
+			nft.RoyaltyShares = []types.RoyaltyShare{types.NewRoyaltyShare(nft.GetCreator(), decValue)}
+		}
 	}
 
 	k.SetNFT(ctx, denomID, nft)
 	return nil
 }
 
-// TransferOwner gets all the ID Collections owned by an address
+// TransferOwner moves an nft to the recipient. The sender is its owner, its approved spender or an operator of the owner.
 func (k Keeper) TransferOwner(ctx sdk.Context,
 	denomID, tokenID string,
-	srcOwner, dstOwner sdk.AccAddress) error {
+	sender, dstOwner sdk.AccAddress) error {
 	if !k.HasDenomID(ctx, denomID) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
 
-	nft, err := k.Authorize(ctx, denomID, tokenID, srcOwner)
+	nft, err := k.AuthorizeSpender(ctx, denomID, tokenID, sender)
 	if err != nil {
 		return err
 	}
@@ -122,6 +181,11 @@ func (k Keeper) TransferOwner(ctx sdk.Context,
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "nft %s is not transferable", nft.Id)
 	}
 
+	if nft.Listed {
+		return sdkerrors.Wrapf(types.ErrListedNFT, "nft %s is listed in market place", nft.Id)
+	}
+
+	srcOwner := nft.GetOwner()
 	nft.Owner = dstOwner.String()
 
 	k.SetNFT(ctx, denomID, nft)
@@ -129,7 +193,41 @@ func (k Keeper) TransferOwner(ctx sdk.Context,
 	return nil
 }
 
-func (k Keeper) SellNFT(ctx sdk.Context, id, denomId string, price string, seller sdk.AccAddress) error {
+// BurnNFT deletes an NFT and removes it from the owner, market place and supply indexes.
+// The sender is its owner, its approved spender or an operator of the owner.
+func (k Keeper) BurnNFT(ctx sdk.Context,
+	denomID, tokenID string,
+	sender sdk.AccAddress) error {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+
+	nft, err := k.AuthorizeSpender(ctx, denomID, tokenID, sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.refundOffers(ctx, denomID, tokenID); err != nil {
+		return err
+	}
+
+	k.deleteNFT(ctx, denomID, nft)
+	k.deleteOwner(ctx, denomID, tokenID, nft.GetOwner())
+	k.DeleteMarketPlaceNFT(ctx, denomID, tokenID)
+	k.decreaseSupply(ctx, denomID)
+
+	// a burned primary sale nft frees up a slot that can be minted again
+	if denom.PrimarySale && denom.AvailableNfts < denom.TotalNfts {
+		denom.AvailableNfts = denom.AvailableNfts + 1
+		k.updateDenom(ctx, denom)
+	}
+	return nil
+}
+
+// SellNFT lists an nft for a crypto price. The sender is its owner, its approved spender or an operator
+// of the owner, and the owner is paid when the nft is bought.
+func (k Keeper) SellNFT(ctx sdk.Context, id, denomId string, price string, sender sdk.AccAddress, expiresAt *time.Time) error {
 
 	if !k.HasDenomID(ctx, denomId) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomId)
@@ -139,30 +237,51 @@ func (k Keeper) SellNFT(ctx sdk.Context, id, denomId string, price string, selle
 		return sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", id, denomId)
 	}
 
-	nft, err := k.Authorize(ctx, denomId, id, seller)
+	nft, err := k.AuthorizeSpender(ctx, denomId, id, sender)
 	if err != nil {
 		return err
 	}
+	seller := nft.GetOwner()
 
 	if !nft.IsTransferable() {
 		return sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
 	}
 
-	nft.Listed = true
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrListingExpired, "listing expiry %s is in the past", expiresAt)
+	}
+
+	listPrice, err := sdk.ParseDecCoin(price)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidPrice, "unable to parse the price %s", err.Error())
+	}
+
+	if err := k.validatePaymentDenom(ctx, listPrice.Denom); err != nil {
+		return err
+	}
 
-	k.SetNFT(ctx, denomId, nft)
-	k.SetNFTMarketPlace(ctx, types.NewMarketPlace(
+	expiresAt, err = k.listingExpiry(ctx, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	order := types.NewMarketPlace(
 		id,
 		denomId,
 		price,
 		types.Crypto,
 		"", "",
 		seller,
-	))
+	)
+	order.ExpiresAt = expiresAt
+
+	k.escrowNFT(ctx, denomId, nft, seller)
+	k.listOrder(ctx, order)
 	return nil
 }
 
-func (k Keeper) SellNFTWithFiat(ctx sdk.Context, id, denomId, currency, fiat_amount string, seller sdk.AccAddress) error {
+// SellNFTWithFiat lists an nft for a fiat price, the sender is authorized as in SellNFT
+func (k Keeper) SellNFTWithFiat(ctx sdk.Context, id, denomId, currency, fiat_amount string, sender sdk.AccAddress, expiresAt *time.Time) error {
 
 	if !k.HasDenomID(ctx, denomId) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomId)
@@ -172,25 +291,36 @@ func (k Keeper) SellNFTWithFiat(ctx sdk.Context, id, denomId, currency, fiat_amo
 		return sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", id, denomId)
 	}
 
-	nft, err := k.Authorize(ctx, denomId, id, seller)
+	nft, err := k.AuthorizeSpender(ctx, denomId, id, sender)
 	if err != nil {
 		return err
 	}
+	seller := nft.GetOwner()
 
 	if !nft.IsTransferable() {
 		return sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
 	}
 
-	nft.Listed = true
-	k.SetNFT(ctx, denomId, nft)
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrListingExpired, "listing expiry %s is in the past", expiresAt)
+	}
 
-	k.SetNFTMarketPlace(ctx, types.NewMarketPlace(
+	expiresAt, err = k.listingExpiry(ctx, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	order := types.NewMarketPlace(
 		id,
 		denomId,
 		"",
 		types.Fiat,
 		currency, fiat_amount, seller,
-	))
+	)
+	order.ExpiresAt = expiresAt
+
+	k.escrowNFT(ctx, denomId, nft, seller)
+	k.listOrder(ctx, order)
 	return nil
 }
 
@@ -212,7 +342,23 @@ func (k Keeper) BuyNFT(ctx sdk.Context, id, denom_id string, buyer sdk.AccAddres
 		return sdkerrors.Wrapf(types.ErrFilledNFT, "%s is already filled", orderNFT.GetNFTID())
 	}
 
+	if orderNFT.GetListedType() == int32(types.EnglishAuction) {
+		return sdkerrors.Wrapf(types.ErrListedNFT, "%s is listed in an auction", orderNFT.GetNFTID())
+	}
+
+	if orderNFT.(types.MarketPlace).IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrListingExpired, "listing of %s has expired", orderNFT.GetNFTID())
+	}
+
 	priceStr := orderNFT.GetPrice()
+	if orderNFT.GetListedType() == int32(types.DescendingAuction) {
+		currentPrice, err := k.GetDutchAuctionPrice(ctx, denom_id, id)
+		if err != nil {
+			return err
+		}
+		priceStr = currentPrice.String()
+	}
+
 	price, err := sdk.ParseDecCoin(priceStr)
 	if err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to parse the  nft price in market place %s", err.Error())
@@ -229,43 +375,20 @@ func (k Keeper) BuyNFT(ctx sdk.Context, id, denom_id string, buyer sdk.AccAddres
 		return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to get the nft  %s", err.Error())
 	}
 
-	royaltyDec, err := sdk.NewDecFromStr(nft.GetRoyalties())
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to convert royalities  %s", err.Error())
-	}
-
-	royalty := royaltyDec.Quo(sdk.OneDec())
-
-	creatorRoyalty := royalty.Mul(price.Amount)
-	creatorToken := sdk.NewCoin(price.Denom, creatorRoyalty.TruncateInt())
-
-	if creatorToken.IsPositive() {
-		err = k.bankKeeper.SendCoins(ctx, buyer, nft.GetCreator(), sdk.Coins{creatorToken})
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer tokens  %s", err.Error())
-		}
+	if err := k.distributeSale(ctx, buyer, denom_id, nft, orderNFT.GetSeller(), price); err != nil {
+		return err
 	}
 
-	sellerAmount := price.Amount.Sub(creatorRoyalty)
-	sellerTokens := sdk.NewCoin(price.Denom, sellerAmount.TruncateInt())
+	k.releaseNFT(ctx, denom_id, nft.(types.NFT), buyer)
 
-	if sellerTokens.IsPositive() {
-		err = k.bankKeeper.SendCoins(ctx, buyer, nft.GetOwner(), sdk.Coins{sellerTokens})
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer tokens  %s", err.Error())
-		}
+	if orderNFT.GetListedType() == int32(types.DescendingAuction) {
+		k.deleteDutchAuction(ctx, denom_id, id)
 	}
 
-	nft1 := nft.(types.NFT)
-	nft1.Owner = buyer.String()
-	nft1.Listed = false
-	k.SetNFT(ctx, denom_id, nft1)
-
 	orderNFT1 := orderNFT.(types.MarketPlace)
+	orderNFT1.Price = priceStr
 	orderNFT1.Buyer = buyer.String()
-	orderNFT1.Filled = true
-	k.SetNFTMarketPlace(ctx, orderNFT1)
-	k.swapOwner(ctx, denom_id, id, nft.GetOwner(), buyer)
+	k.fillOrder(ctx, orderNFT1)
 	return nil
 }
 
@@ -287,6 +410,14 @@ func (k Keeper) BuyNFTWithFiat(ctx sdk.Context, id, denom_id string, currency, a
 		return sdkerrors.Wrapf(types.ErrFilledNFT, "%s is already filled", orderNFT.GetNFTID())
 	}
 
+	if orderNFT.GetListedType() == int32(types.EnglishAuction) || orderNFT.GetListedType() == int32(types.DescendingAuction) {
+		return sdkerrors.Wrapf(types.ErrListedNFT, "%s is listed in an auction", orderNFT.GetNFTID())
+	}
+
+	if orderNFT.(types.MarketPlace).IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrListingExpired, "listing of %s has expired", orderNFT.GetNFTID())
+	}
+
 	if !strings.EqualFold(amount, orderNFT.GetFiatAmount()) {
 		return sdkerrors.Wrapf(types.ErrFilledNFT, "%s amount %s is invalid ", amount, orderNFT.GetCurrency())
 	}
@@ -296,20 +427,15 @@ func (k Keeper) BuyNFTWithFiat(ctx sdk.Context, id, denom_id string, currency, a
 		return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to get the nft  %s", err.Error())
 	}
 
-	nft1 := nft.(types.NFT)
-	nft1.Owner = buyer.String()
-	nft1.Listed = false
-	k.SetNFT(ctx, denom_id, nft1)
+	k.releaseNFT(ctx, denom_id, nft.(types.NFT), buyer)
 
 	orderNFT1 := orderNFT.(types.MarketPlace)
 	orderNFT1.Buyer = buyer.String()
-	orderNFT1.Filled = true
 	orderNFT1.OrderRefId = order_ref_id
 	orderNFT1.Currency = currency
 	orderNFT1.FiatAmount = amount
 
-	k.SetNFTMarketPlace(ctx, orderNFT1)
-	k.swapOwner(ctx, denom_id, id, nft.GetOwner(), buyer)
+	k.fillOrder(ctx, orderNFT1)
 	return nil
 }
 
@@ -319,7 +445,7 @@ func (k Keeper) UpdateCommunity(ctx sdk.Context, description, data, id string, t
 		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
 	}
 
-	if !strings.EqualFold(community.Creator, owner.String()) {
+	if !k.GetCommunityRole(ctx, community, owner).CanManage() {
 		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to update the community: %s", owner.String())
 	}
 
@@ -339,24 +465,87 @@ func (k Keeper) UpdateCommunity(ctx sdk.Context, description, data, id string, t
 	return nil
 }
 
+// DeleteCommunity deletes a community without denoms and every state kept for it. A community with denoms
+// can only be archived with force, which keeps its denoms and stops it accepting new ones. A community
+// is never deleted while its treasury holds funds, as they could not be spent anymore.
+func (k Keeper) DeleteCommunity(ctx sdk.Context, id string, owner sdk.AccAddress, force bool) (archived bool, err error) {
+	community, ok := k.GetCommunityByID(ctx, id)
+	if !ok {
+		return false, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !strings.EqualFold(community.Creator, owner.String()) {
+		return false, sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to delete the community: %s", owner.String())
+	}
+
+	if k.hasCommunityDenoms(ctx, id) {
+		if !force {
+			return false, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s has denoms, force to archive it", id)
+		}
+		community.Archived = true
+		k.SetCommunity(ctx, community)
+		return true, nil
+	}
+
+	if balance := k.bankKeeper.GetAllBalances(ctx, types.CommunityTreasuryAddress(id)); !balance.IsZero() {
+		return false, sdkerrors.Wrapf(types.ErrTreasury, "community %s treasury still holds %s", id, balance)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCommunityID(id))
+	store.Delete(types.KeyTreasuryFlow(id))
+	store.Delete(types.KeyCollectedFees(id))
+	k.deleteCommunityMembers(ctx, id)
+	k.deleteCommunityInvites(ctx, id)
+	k.deleteJoinRequests(ctx, id)
+	k.deleteCommunityProposals(ctx, id)
+	return false, nil
+}
+
+// TransferCommunityOwnership makes the recipient the creator of the community
+func (k Keeper) TransferCommunityOwnership(ctx sdk.Context, id string, recipient, sender sdk.AccAddress) error {
+	community, ok := k.GetCommunityByID(ctx, id)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !strings.EqualFold(community.Creator, sender.String()) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to transfer the community: %s", sender.String())
+	}
+
+	// the previous owner stays as a plain member and the new owner is no longer stored as a member
+	k.addCommunityMember(ctx, id, sender)
+	k.deleteCommunityMember(ctx, id, recipient)
+
+	community.Creator = recipient.String()
+	k.SetCommunity(ctx, community)
+	return nil
+}
+
+func (k Keeper) hasCommunityDenoms(ctx sdk.Context, id string) bool {
+	return len(k.getCommunityDenoms(ctx, id)) > 0
+}
+
+// UpdateDenom updates the description and symbol of a denom of the owner, a "[do-not-modify]"
+// argument keeps the current value. The owner is the creator or whoever accepted the denom ownership.
 func (k Keeper) UpdateDenom(ctx sdk.Context, description, symbol, id string, owner sdk.AccAddress) error {
-	denom, err := k.GetDenom(ctx, id)
-	if err != nil {
+	if !k.HasDenomID(ctx, id) {
 		return sdkerrors.Wrapf(types.ErrDenomNotFound, "denom not found with id %s", id)
 	}
 
-	if !strings.EqualFold(denom.Creator, owner.String()) {
-		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to update the denom: %s", owner.String())
+	denom, err := k.authorizeDenomCreator(ctx, id, owner)
+	if err != nil {
+		return err
 	}
 
-	if denom.Description != "[do-not-modify]" {
+	if description != "[do-not-modify]" {
 		denom.Description = description
 	}
 
-	if denom.Symbol != "[do-not-modify]" {
+	if symbol != "[do-not-modify]" {
 		denom.Symbol = symbol
 	}
 
-	k.SetDenom(ctx, denom)
+	k.updateDenom(ctx, denom)
 	return nil
 }
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/AutonomyNetwork/nft"
+	"github.com/AutonomyNetwork/nft/keeper"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// nolint: deadcode unused
+var (
+	denomID  = "denomid"
+	denomNm  = "denomnm"
+	denomID2 = "denomid2"
+	denomNm2 = "denomnm2"
+	denomID3 = "denomid3"
+	denomNm3 = "denomnm3"
+
+	tokenID  = "tokenid"
+	tokenID2 = "tokenid2"
+	tokenID3 = "tokenid3"
+
+	tokenNm  = "tokennm"
+	tokenNm2 = "tokennm2"
+	tokenNm3 = "tokennm3"
+
+	address  = CreateTestAddrs(1)[0]
+	address2 = CreateTestAddrs(2)[1]
+	address3 = CreateTestAddrs(3)[2]
+	address4 = CreateTestAddrs(4)[3]
+
+	tokenURI  = "https://google.com/token-1.json"
+	tokenData = "{\"a\":\"b\"}"
+
+	chainID   = "autonomy-test"
+	blockTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type KeeperSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	storeKey     storetypes.StoreKey
+	paramsKeeper paramskeeper.Keeper
+	paramSpace   paramstypes.Subspace
+	cdc          codec.Codec
+	keeper       keeper.Keeper
+	msgServer    types.MsgServer
+	msgRouter    *baseapp.MsgServiceRouter
+	bankKeeper   bankkeeper.BaseKeeper
+	accKeeper    authkeeper.AccountKeeper
+	authzKeeper  authzkeeper.Keeper
+}
+
+func (suite *KeeperSuite) SetupTest() {
+	keys := sdk.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, authzkeeper.StoreKey, paramstypes.StoreKey, types.StoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	for _, key := range tkeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeTransient, db)
+	}
+	suite.Require().NoError(cms.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	suite.cdc = codec.NewProtoCodec(registry)
+	suite.storeKey = keys[types.StoreKey]
+
+	suite.paramsKeeper = paramskeeper.NewKeeper(suite.cdc, codec.NewLegacyAmino(), keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
+	suite.paramSpace = suite.paramsKeeper.Subspace(types.ModuleName)
+	maccPerms := map[string][]string{
+		minttypes.ModuleName: {authtypes.Minter},
+		types.ModuleName:     nil,
+		types.FeePoolName:    nil,
+	}
+	suite.accKeeper = authkeeper.NewAccountKeeper(suite.cdc, keys[authtypes.StoreKey], suite.paramsKeeper.Subspace(authtypes.ModuleName),
+		authtypes.ProtoBaseAccount, maccPerms, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	suite.bankKeeper = bankkeeper.NewBaseKeeper(suite.cdc, keys[banktypes.StoreKey], suite.accKeeper,
+		suite.paramsKeeper.Subspace(banktypes.ModuleName), map[string]bool{})
+	suite.keeper = keeper.NewKeeper(suite.cdc, keys[types.StoreKey], suite.accKeeper, suite.bankKeeper,
+		suite.paramSpace, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	suite.msgServer = keeper.NewMsgServerImpl(suite.keeper)
+
+	// messages are routed the way the app routes them, including those executed by gov and authz
+	suite.msgRouter = baseapp.NewMsgServiceRouter()
+	suite.msgRouter.SetInterfaceRegistry(registry)
+	nft.NewAppModule(suite.cdc, suite.keeper).RegisterServices(module.NewConfigurator(suite.cdc, suite.msgRouter, baseapp.NewGRPCQueryRouter()))
+	suite.authzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], suite.cdc, suite.msgRouter, suite.accKeeper)
+
+	suite.ctx = sdk.NewContext(cms, tmproto.Header{ChainID: chainID, Time: blockTime}, false, log.NewNopLogger())
+	suite.accKeeper.SetParams(suite.ctx, authtypes.DefaultParams())
+	suite.bankKeeper.SetParams(suite.ctx, banktypes.DefaultParams())
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+
+	suite.createDenom(denomID, denomNm, address)
+	suite.createDenom(denomID2, denomNm2, address)
+	suite.createDenom(denomID3, denomNm3, address)
+}
+
+func TestKeeperSuite(t *testing.T) {
+	suite.Run(t, new(KeeperSuite))
+}
+
+// createDenom creates an open denom of the creator without royalties
+func (suite *KeeperSuite) createDenom(id, name string, creator sdk.AccAddress) {
+	err := suite.keeper.CreateDenom(suite.ctx, id, name, id, "", "", creator.String(), "", nil, "", false, 0, 0, "",
+		types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+}
+
+// createPrimarySale creates a primary sale denom of address selling total nfts at amount stake each
+func (suite *KeeperSuite) createPrimarySale(id string, total, amount int64) types.Denom {
+	paymentInfo := types.PaymentInfo{Amount: amount, Currency: sdk.DefaultBondDenom}
+	err := suite.keeper.CreateDenom(suite.ctx, id, id, id, "", "", address.String(), "", nil, "", true, total, total, "",
+		paymentInfo, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, id)
+	suite.Require().NoError(err)
+	return denom
+}
+
+// mintNFT mints a transferable nft of the denom to the owner with a single royalty paid to the creator
+func (suite *KeeperSuite) mintNFT(denomID, tokenID, royalties string, owner, creator sdk.AccAddress) {
+	metadata := types.Metadata{Name: tokenNm, Description: tokenURI}
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, royalties, true, owner, creator, metadata, tokenData, nil)
+	suite.Require().NoError(err)
+}
+
+// fund mints coins to the address
+func (suite *KeeperSuite) fund(address sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, address, coins))
+}
+
+func (suite *KeeperSuite) balance(address sdk.AccAddress) sdk.Int {
+	return suite.bankKeeper.GetBalance(suite.ctx, address, sdk.DefaultBondDenom).Amount
+}
+
+// setFeeBps sets the protocol marketplace fee
+func (suite *KeeperSuite) setFeeBps(feeBps uint32) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MarketplaceFeeBps = feeBps
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// checkSupply checks the supply of every denom matches its minted nfts
+func (suite *KeeperSuite) checkSupply() {
+	for _, denom := range suite.keeper.GetDenoms(suite.ctx) {
+		suite.Equal(uint64(len(suite.keeper.GetNFTs(suite.ctx, denom.Id))), suite.keeper.GetTotalSupply(suite.ctx, denom.Id), denom.Id)
+	}
+}
+
+// CreateTestAddrs creates test addresses
+func CreateTestAddrs(numAddrs int) []sdk.AccAddress {
+	var addresses []sdk.AccAddress
+	for i := 0; i < numAddrs; i++ {
+		addr := make([]byte, 20)
+		addr[0] = byte(i + 1)
+		addresses = append(addresses, sdk.AccAddress(addr))
+	}
+	return addresses
+}
+
+func (suite *KeeperSuite) TestBurnNFT() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+
+	err := suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address2))
+
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupply(suite.ctx, denomID))
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+	suite.Equal([]string{tokenID2}, suite.keeper.GetOwner(suite.ctx, address2, denomID).IDCollections[0].NftIds)
+	suite.checkSupply()
+
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address2)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperSuite) TestBurnPrimarySaleNFT() {
+	suite.createPrimarySale("saledenom", 2, 0)
+	for _, id := range []string{tokenID, tokenID2} {
+		_, err := suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: id, DenomId: "saledenom", Transferable: true, Creator: address2.String()})
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: tokenID3, DenomId: "saledenom", Transferable: true, Creator: address2.String()})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the burned nft frees up a slot of the primary sale
+	suite.Require().NoError(suite.keeper.BurnNFT(suite.ctx, "saledenom", tokenID, address2))
+	denom, err := suite.keeper.GetDenom(suite.ctx, "saledenom")
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), denom.AvailableNfts)
+	suite.Equal(int64(2), denom.TotalNfts)
+
+	_, err = suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: tokenID3, DenomId: "saledenom", Transferable: true, Creator: address2.String()})
+	suite.Require().NoError(err)
+	denom, err = suite.keeper.GetDenom(suite.ctx, "saledenom")
+	suite.Require().NoError(err)
+	suite.Zero(denom.AvailableNfts)
+	suite.Equal(uint64(2), suite.keeper.GetTotalSupply(suite.ctx, "saledenom"))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,6 +10,92 @@ import (
 	"github.com/AutonomyNetwork/nft/types"
 )
 
+// GetEscrowAddress returns the module account that holds listed nfts
+func (k Keeper) GetEscrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// escrowNFT moves the custody of a listed nft from the seller to the escrow account
+func (k Keeper) escrowNFT(ctx sdk.Context, denomID string, nft types.NFT, seller sdk.AccAddress) {
+	escrow := k.GetEscrowAddress()
+	nft.Owner = escrow.String()
+	nft.Listed = true
+
+	k.SetNFT(ctx, denomID, nft)
+	k.swapOwner(ctx, denomID, nft.GetID(), seller, escrow)
+}
+
+// releaseNFT moves the custody of a listed nft from the escrow account to the recipient
+func (k Keeper) releaseNFT(ctx sdk.Context, denomID string, nft types.NFT, recipient sdk.AccAddress) {
+	holder := nft.GetOwner()
+	nft.Owner = recipient.String()
+	nft.Listed = false
+
+	k.SetNFT(ctx, denomID, nft)
+	k.swapOwner(ctx, denomID, nft.GetID(), holder, recipient)
+}
+
+// GetRoyaltyShares returns the royalty recipients of an nft, falling back to the default recipients of its denom
+func (k Keeper) GetRoyaltyShares(ctx sdk.Context, denomID string, nft types.NFT) []types.RoyaltyShare {
+	if len(nft.GetRoyaltyShares()) > 0 {
+		return nft.GetRoyaltyShares()
+	}
+
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return nil
+	}
+	return denom.RoyaltyShares
+}
+
+// distributeSale pays the marketplace fee, every royalty recipient and the seller proceeds of a sale
+// from the payer. The community treasury cut of each royalty is taken from its recipient and the
+// rounding dust of the royalties goes to the seller.
+func (k Keeper) distributeSale(ctx sdk.Context, payer sdk.AccAddress, denomID string, nft exported.NFT, seller sdk.AccAddress, price sdk.DecCoin) error {
+	fee, err := k.payMarketplaceFee(ctx, payer, denomID, nft.GetID(), price)
+	if err != nil {
+		return err
+	}
+
+	// royalties are capped at what is left of the price after the fee, so that a high fee
+	// combined with a high royalty never makes the sale fail
+	available := price.Amount.Sub(sdk.NewDecFromInt(fee.Amount)).TruncateInt()
+	royaltyPaid := sdk.ZeroInt()
+	for _, share := range k.GetRoyaltyShares(ctx, denomID, nft.(types.NFT)) {
+		royaltyToken := sdk.NewCoin(price.Denom, sdk.MinInt(share.Share.Mul(price.Amount).TruncateInt(), available.Sub(royaltyPaid)))
+		if !royaltyToken.IsPositive() {
+			continue
+		}
+
+		cut, err := k.payTreasuryCut(ctx, payer, denomID, nft.GetID(), royaltyToken, types.TreasurySourceRoyalty)
+		if err != nil {
+			return err
+		}
+		royaltyPaid = royaltyPaid.Add(royaltyToken.Amount)
+
+		royaltyToken = royaltyToken.Sub(cut)
+		if !royaltyToken.IsPositive() {
+			continue
+		}
+
+		err = k.bankKeeper.SendCoins(ctx, payer, share.GetAddress(), sdk.Coins{royaltyToken})
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer tokens  %s", err.Error())
+		}
+	}
+
+	sellerAmount := price.Amount.Sub(sdk.NewDecFromInt(royaltyPaid)).Sub(sdk.NewDecFromInt(fee.Amount))
+	sellerTokens := sdk.NewCoin(price.Denom, sellerAmount.TruncateInt())
+
+	if sellerTokens.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, payer, seller, sdk.Coins{sellerTokens})
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer tokens  %s", err.Error())
+		}
+	}
+	return nil
+}
+
 func (k Keeper) SetNFTMarketPlace(ctx sdk.Context, order types.MarketPlace) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -44,5 +132,144 @@ func (k Keeper) GetMarketPlace(ctx sdk.Context) (marketPlace []types.MarketPlace
 
 func (k Keeper) DeleteMarketPlaceNFT(ctx sdk.Context, denomID, tokenID string) {
 	store := ctx.KVStore(k.storeKey)
+
+	if bz := store.Get(types.KeyMarketPlaceNFT(denomID, tokenID)); bz != nil {
+		var order types.MarketPlace
+		k.cdc.MustUnmarshal(bz, &order)
+		k.dequeueOrder(ctx, order)
+	}
 	store.Delete(types.KeyMarketPlaceNFT(denomID, tokenID))
 }
+
+// listOrder stores a new market place order, replacing a previously filled one, and schedules its expiry
+func (k Keeper) listOrder(ctx sdk.Context, order types.MarketPlace) {
+	store := ctx.KVStore(k.storeKey)
+
+	if bz := store.Get(types.KeyMarketPlaceNFT(order.DenomID, order.NftId)); bz != nil {
+		var previous types.MarketPlace
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.dequeueOrder(ctx, previous)
+	}
+
+	k.SetNFTMarketPlace(ctx, order)
+	k.InsertOrderQueue(ctx, order)
+}
+
+// fillOrder marks an order as filled at the current block time and schedules it for pruning
+func (k Keeper) fillOrder(ctx sdk.Context, order types.MarketPlace) {
+	k.dequeueOrder(ctx, order)
+
+	filledAt := ctx.BlockTime()
+	order.Filled = true
+	order.FilledAt = &filledAt
+	k.SetNFTMarketPlace(ctx, order)
+	k.InsertOrderQueue(ctx, order)
+}
+
+// InsertOrderQueue schedules the expiry of an open order or the pruning of a filled one
+func (k Keeper) InsertOrderQueue(ctx sdk.Context, order types.MarketPlace) {
+	store := ctx.KVStore(k.storeKey)
+	if order.Filled && order.FilledAt != nil {
+		store.Set(types.KeyFilledOrderQueue(*order.FilledAt, order.DenomID, order.NftId), []byte{})
+	} else if !order.Filled && order.ExpiresAt != nil {
+		store.Set(types.KeyListingQueue(*order.ExpiresAt, order.DenomID, order.NftId), []byte{})
+	}
+}
+
+// dequeueOrder removes an order from the expiry and pruning queues
+func (k Keeper) dequeueOrder(ctx sdk.Context, order types.MarketPlace) {
+	store := ctx.KVStore(k.storeKey)
+	if order.ExpiresAt != nil {
+		store.Delete(types.KeyListingQueue(*order.ExpiresAt, order.DenomID, order.NftId))
+	}
+	if order.FilledAt != nil {
+		store.Delete(types.KeyFilledOrderQueue(*order.FilledAt, order.DenomID, order.NftId))
+	}
+}
+
+// DequeueListing removes an open order from the expiry queue, the order is kept and can be delisted by its seller
+func (k Keeper) DequeueListing(ctx sdk.Context, order types.MarketPlace) {
+	if order.ExpiresAt == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyListingQueue(*order.ExpiresAt, order.DenomID, order.NftId))
+}
+
+// ExpireListing returns the nft of an expired order to the seller and removes the order
+func (k Keeper) ExpireListing(ctx sdk.Context, order types.MarketPlace) error {
+	nft, err := k.GetNFT(ctx, order.DenomID, order.NftId)
+	if err != nil {
+		return err
+	}
+
+	k.releaseNFT(ctx, order.DenomID, nft.(types.NFT), order.GetSeller())
+	k.DeleteMarketPlaceNFT(ctx, order.DenomID, order.NftId)
+	return nil
+}
+
+// IterateExpiredListings iterates over the unfilled orders that expired at or before the given time
+func (k Keeper) IterateExpiredListings(ctx sdk.Context, expiresAt time.Time, cb func(order types.MarketPlace) (stop bool)) {
+	k.iterateOrderQueue(ctx, types.PrefixListingQueue, types.KeyListingQueue(expiresAt, "", ""), cb)
+}
+
+// IterateFilledOrders iterates over the orders that were filled at or before the given time
+func (k Keeper) IterateFilledOrders(ctx sdk.Context, filledAt time.Time, cb func(order types.MarketPlace) (stop bool)) {
+	k.iterateOrderQueue(ctx, types.PrefixFilledOrderQueue, types.KeyFilledOrderQueue(filledAt, "", ""), cb)
+}
+
+func (k Keeper) iterateOrderQueue(ctx sdk.Context, prefix, end []byte, cb func(order types.MarketPlace) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(prefix, sdk.PrefixEndBytes(end))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, denomID, tokenID, err := types.SplitKeyOrderQueue(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		order, err := k.GetMarketPlaceNFT(ctx, denomID, tokenID)
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(order.(types.MarketPlace)) {
+			break
+		}
+	}
+}
+
+// DelistNFT removes an open order from the market place and returns the nft to the seller
+func (k Keeper) DelistNFT(ctx sdk.Context, denomID, tokenID string, seller sdk.AccAddress) error {
+	order, err := k.GetMarketPlaceNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if !seller.Equals(order.GetSeller()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is unathorized to perform this operation", seller.String())
+	}
+
+	if order.GetFilled() {
+		return sdkerrors.Wrapf(types.ErrFilledNFT, "%s is already filled", order.GetNFTID())
+	}
+
+	if order.GetListedType() == int32(types.EnglishAuction) {
+		return sdkerrors.Wrapf(types.ErrListedNFT, "%s is listed in an auction", order.GetNFTID())
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if order.GetListedType() == int32(types.DescendingAuction) {
+		k.deleteDutchAuction(ctx, denomID, tokenID)
+	}
+
+	k.releaseNFT(ctx, denomID, nft.(types.NFT), seller)
+	k.DeleteMarketPlaceNFT(ctx, denomID, tokenID)
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestSellNFTEscrow() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "100stake", address2, nil))
+
+	// the listed nft is held by the module escrow until it is bought or delisted
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(suite.keeper.GetEscrowAddress(), token.GetOwner())
+	suite.True(token.(types.NFT).Listed)
+	suite.Zero(suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+
+	suite.Require().Error(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address2, address3))
+	suite.Require().Error(suite.keeper.UpdateNFT(suite.ctx, denomID, tokenID, tokenNm2, "", "[do-not-modify]", address2))
+
+	err = suite.keeper.DelistNFT(suite.ctx, denomID, tokenID, address3)
+	suite.Require().Error(err)
+	suite.Require().NoError(suite.keeper.DelistNFT(suite.ctx, denomID, tokenID, address2))
+
+	token, err = suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, token.GetOwner())
+	suite.False(token.(types.NFT).Listed)
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+
+	_, err = suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownMarketPlace)
+}
+
+func (suite *KeeperSuite) TestBuyNFTReleasesEscrow() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "100stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, token.GetOwner())
+	suite.False(token.(types.NFT).Listed)
+	suite.Equal(sdk.NewInt(100), suite.balance(address2))
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address3))
+	suite.Zero(suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, suite.keeper.GetEscrowAddress()))
+
+	err = suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address4)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperSuite) TestListingExpiry() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	expiresAt := blockTime.Add(time.Hour)
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "100stake", address2, &expiresAt))
+
+	nft.EndBlocker(suite.ctx, suite.keeper)
+	_, err := suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(expiresAt)
+	nft.EndBlocker(suite.ctx, suite.keeper)
+
+	_, err = suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownMarketPlace)
+
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address2, token.GetOwner())
+	suite.False(token.(types.NFT).Listed)
+}
+
+func (suite *KeeperSuite) TestPruneFilledOrders() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.FilledOrderRetention = 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "100stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// filled orders are kept for the retention period
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	nft.EndBlocker(suite.ctx, suite.keeper)
+	order, err := suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.True(order.GetFilled())
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(24 * time.Hour))
+	nft.EndBlocker(suite.ctx, suite.keeper)
+	_, err = suite.keeper.GetMarketPlaceNFT(suite.ctx, denomID, tokenID)
+	suite.Require().ErrorIs(err, types.ErrUnknownMarketPlace)
+}
+
+func (suite *KeeperSuite) TestBuyNFTRoyaltyShares() {
+	shares := []types.RoyaltyShare{
+		{Address: address.String(), Share: sdk.NewDecWithPrec(1, 1)},
+		{Address: address4.String(), Share: sdk.NewDecWithPrec(5, 2)},
+	}
+	metadata := types.Metadata{Name: tokenNm}
+	suite.Require().NoError(suite.keeper.MintNFT(suite.ctx, denomID, tokenID, "", true, address2, address, metadata, tokenData, shares))
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	suite.Equal(sdk.NewInt(100), suite.balance(address))
+	suite.Equal(sdk.NewInt(50), suite.balance(address4))
+	suite.Equal(sdk.NewInt(850), suite.balance(address2))
+}
+
+func (suite *KeeperSuite) TestDenomRoyaltyShares() {
+	shares := []types.RoyaltyShare{
+		types.NewRoyaltyShare(address, sdk.NewDecWithPrec(5, 2)),
+		types.NewRoyaltyShare(address4, sdk.NewDecWithPrec(5, 2)),
+	}
+	err := suite.keeper.CreateDenom(suite.ctx, "royaltydenom", "royaltydenom", "royaltydenom", "", "", address.String(), "", nil, "", false, 0, 0, "",
+		types.PaymentInfo{}, shares, types.TokenGate{})
+	suite.Require().NoError(err)
+	suite.mintNFT("royaltydenom", tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	// nfts without their own royalty pay the default recipients of the denom
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, "royaltydenom", "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, "royaltydenom", address3))
+
+	suite.Equal(sdk.NewInt(50), suite.balance(address))
+	suite.Equal(sdk.NewInt(50), suite.balance(address4))
+	suite.Equal(sdk.NewInt(900), suite.balance(address2))
+}
+
+func (suite *KeeperSuite) TestRoyaltyDustToSeller() {
+	shares := []types.RoyaltyShare{
+		types.NewRoyaltyShare(address, sdk.NewDecWithPrec(15, 3)),
+		types.NewRoyaltyShare(address4, sdk.NewDecWithPrec(15, 3)),
+	}
+	metadata := types.Metadata{Name: tokenNm}
+	suite.Require().NoError(suite.keeper.MintNFT(suite.ctx, denomID, tokenID, "", true, address2, address, metadata, tokenData, shares))
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99)))
+
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, denomID, "99stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, denomID, address3))
+
+	// each 1.5% royalty of 99 truncates to 1, the rounding dust goes to the seller
+	suite.Equal(sdk.NewInt(1), suite.balance(address))
+	suite.Equal(sdk.NewInt(1), suite.balance(address4))
+	suite.Equal(sdk.NewInt(97), suite.balance(address2))
+}
+
+func (suite *KeeperSuite) TestUpdateNFTRoyalty() {
+	shares := []types.RoyaltyShare{
+		types.NewRoyaltyShare(address, sdk.NewDecWithPrec(1, 1)),
+		types.NewRoyaltyShare(address4, sdk.NewDecWithPrec(5, 2)),
+	}
+	metadata := types.Metadata{Name: tokenNm}
+	suite.Require().NoError(suite.keeper.MintNFT(suite.ctx, denomID, tokenID, "", true, address2, address, metadata, tokenData, shares))
+	suite.mintNFT(denomID, tokenID2, "0.1", address2, address)
+
+	// a single royalty cannot replace a split
+	err := suite.keeper.UpdateNFT(suite.ctx, denomID, tokenID, "[do-not-modify]", "[do-not-modify]", "0.2", address2)
+	suite.Require().ErrorIs(err, types.ErrInvalidRoyalty)
+
+	suite.Require().NoError(suite.keeper.UpdateNFT(suite.ctx, denomID, tokenID2, "[do-not-modify]", "[do-not-modify]", "0.2", address2))
+	token, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID2)
+	suite.Require().NoError(err)
+	suite.Equal([]types.RoyaltyShare{types.NewRoyaltyShare(address, sdk.NewDecWithPrec(2, 1))}, token.(types.NFT).RoyaltyShares)
+}
+
+func (suite *KeeperSuite) TestPrimarySaleRoyaltyShares() {
+	shares := []types.RoyaltyShare{types.NewRoyaltyShare(address4, sdk.NewDecWithPrec(1, 1))}
+	err := suite.keeper.CreateDenom(suite.ctx, "saledenom", "saledenom", "saledenom", "", "", address.String(), "", nil, "", true, 10, 10, "",
+		types.PaymentInfo{}, shares, types.TokenGate{})
+	suite.Require().NoError(err)
+	denom, err := suite.keeper.GetDenom(suite.ctx, "saledenom")
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID, true, address3, types.Metadata{Name: tokenNm}, tokenData, nil)
+	suite.Require().NoError(err)
+
+	// the minter owns the nft but the denom creator and its royalty recipients are kept
+	token, err := suite.keeper.GetNFT(suite.ctx, "saledenom", tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, token.GetOwner())
+	suite.Equal(address, token.GetCreator())
+	suite.Equal(shares, token.(types.NFT).RoyaltyShares)
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// JoinCommunity adds the address to the members of the community as allowed by its membership
+// policy. With the approval policy a join request is stored instead and pending is true.
+func (k Keeper) JoinCommunity(ctx sdk.Context, id string, address sdk.AccAddress, inviteCode string) (pending bool, err error) {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return false, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if community.Archived {
+		return false, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s is archived", id)
+	}
+
+	if k.GetCommunityRole(ctx, community, address) != types.RoleNone {
+		return false, sdkerrors.Wrapf(types.ErrCommunityNotFound, "address already exist")
+	}
+
+	switch community.MembershipPolicy {
+	case types.PolicyApproval:
+		if _, found := k.GetJoinRequest(ctx, id, address); found {
+			return false, sdkerrors.Wrapf(types.ErrMembership, "%s already requested to join community %s", address, id)
+		}
+		k.SetJoinRequest(ctx, types.JoinRequest{CommunityId: id, Address: address.String(), RequestedAt: ctx.BlockTime()})
+		return true, nil
+
+	case types.PolicyInvite:
+		if err := k.useCommunityInvite(ctx, id, inviteCode); err != nil {
+			return false, err
+		}
+
+	case types.PolicyTokenGated:
+		if k.GetTotalSupplyOfOwner(ctx, community.GateDenomId, address) == 0 {
+			return false, sdkerrors.Wrapf(types.ErrMembership, "%s must hold an nft of %s to join community %s", address, community.GateDenomId, id)
+		}
+	}
+
+	k.addCommunityMember(ctx, id, address)
+	return false, nil
+}
+
+// SetMembershipPolicy changes how addresses join the community
+func (k Keeper) SetMembershipPolicy(ctx sdk.Context, id string, policy types.MembershipPolicy, gateDenomID string, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanManage() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to update the community: %s", sender)
+	}
+
+	if policy == types.PolicyTokenGated {
+		if !k.HasDenomID(ctx, gateDenomID) {
+			return sdkerrors.Wrapf(types.ErrInvalidDenom, "gate denom %s not exists", gateDenomID)
+		}
+	} else {
+		gateDenomID = ""
+	}
+
+	community.MembershipPolicy = policy
+	community.GateDenomId = gateDenomID
+	k.SetCommunity(ctx, community)
+	return nil
+}
+
+// CreateCommunityInvite adds an invite code that can be used maxUses times to join the community
+func (k Keeper) CreateCommunityInvite(ctx sdk.Context, id string, codeHash []byte, maxUses uint64, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanModerate() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot invite members to community %s", sender, id)
+	}
+
+	k.SetCommunityInvite(ctx, types.CommunityInvite{CommunityId: id, CodeHash: codeHash, UsesLeft: maxUses})
+	return nil
+}
+
+// ApproveJoinRequest adds the address of a pending join request to the members, or drops the request with reject
+func (k Keeper) ApproveJoinRequest(ctx sdk.Context, id string, address sdk.AccAddress, reject bool, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanModerate() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot approve join requests of community %s", sender, id)
+	}
+
+	if _, found := k.GetJoinRequest(ctx, id, address); !found {
+		return sdkerrors.Wrapf(types.ErrMembership, "%s has no join request in community %s", address, id)
+	}
+	k.deleteJoinRequest(ctx, id, address)
+
+	if !reject {
+		k.addCommunityMember(ctx, id, address)
+	}
+	return nil
+}
+
+// LeaveCommunity removes the address from the members of the community. The owner cannot
+// leave and has to transfer the community first.
+func (k Keeper) LeaveCommunity(ctx sdk.Context, id string, address sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	switch k.GetCommunityRole(ctx, community, address) {
+	case types.RoleNone:
+		return sdkerrors.Wrapf(types.ErrMembership, "%s is not a member of community %s", address, id)
+	case types.RoleOwner:
+		return sdkerrors.Wrapf(types.ErrMembership, "the owner cannot leave community %s", id)
+	}
+
+	k.deleteCommunityMember(ctx, id, address)
+	return nil
+}
+
+// RemoveMember removes a member with a lower role than the sender from the community
+func (k Keeper) RemoveMember(ctx sdk.Context, id string, address, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	role := k.GetCommunityRole(ctx, community, address)
+	if role == types.RoleNone {
+		return sdkerrors.Wrapf(types.ErrMembership, "%s is not a member of community %s", address, id)
+	}
+
+	senderRole := k.GetCommunityRole(ctx, community, sender)
+	if !senderRole.CanModerate() || senderRole <= role {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot remove %s from community %s", sender, address, id)
+	}
+
+	k.deleteCommunityMember(ctx, id, address)
+	return nil
+}
+
+func (k Keeper) useCommunityInvite(ctx sdk.Context, id, inviteCode string) error {
+	if len(inviteCode) == 0 {
+		return sdkerrors.Wrapf(types.ErrMembership, "community %s is invite only", id)
+	}
+
+	invite, found := k.GetCommunityInvite(ctx, id, types.InviteCodeHash(inviteCode))
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMembership, "invalid invite code for community %s", id)
+	}
+
+	invite.UsesLeft--
+	if invite.UsesLeft == 0 {
+		k.deleteCommunityInvite(ctx, id, invite.CodeHash)
+		return nil
+	}
+	k.SetCommunityInvite(ctx, invite)
+	return nil
+}
+
+func (k Keeper) SetJoinRequest(ctx sdk.Context, request types.JoinRequest) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&request)
+	store.Set(types.KeyJoinRequest(request.CommunityId, sdk.MustAccAddressFromBech32(request.Address)), bz)
+}
+
+func (k Keeper) GetJoinRequest(ctx sdk.Context, id string, address sdk.AccAddress) (types.JoinRequest, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyJoinRequest(id, address))
+	if bz == nil {
+		return types.JoinRequest{}, false
+	}
+
+	var request types.JoinRequest
+	k.cdc.MustUnmarshal(bz, &request)
+	return request, true
+}
+
+func (k Keeper) deleteJoinRequest(ctx sdk.Context, id string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyJoinRequest(id, address))
+}
+
+func (k Keeper) deleteJoinRequests(ctx sdk.Context, id string) {
+	for _, request := range k.getJoinRequests(ctx, types.KeyJoinRequest(id, nil)) {
+		k.deleteJoinRequest(ctx, id, sdk.MustAccAddressFromBech32(request.Address))
+	}
+}
+
+// GetJoinRequests returns the pending join requests of every community
+func (k Keeper) GetJoinRequests(ctx sdk.Context) []types.JoinRequest {
+	return k.getJoinRequests(ctx, types.PrefixJoinRequest)
+}
+
+func (k Keeper) getJoinRequests(ctx sdk.Context, prefix []byte) (requests []types.JoinRequest) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.JoinRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func (k Keeper) SetCommunityInvite(ctx sdk.Context, invite types.CommunityInvite) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&invite)
+	store.Set(types.KeyCommunityInvite(invite.CommunityId, invite.CodeHash), bz)
+}
+
+func (k Keeper) GetCommunityInvite(ctx sdk.Context, id string, codeHash []byte) (types.CommunityInvite, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyCommunityInvite(id, codeHash))
+	if bz == nil {
+		return types.CommunityInvite{}, false
+	}
+
+	var invite types.CommunityInvite
+	k.cdc.MustUnmarshal(bz, &invite)
+	return invite, true
+}
+
+func (k Keeper) deleteCommunityInvite(ctx sdk.Context, id string, codeHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCommunityInvite(id, codeHash))
+}
+
+func (k Keeper) deleteCommunityInvites(ctx sdk.Context, id string) {
+	for _, invite := range k.getCommunityInvites(ctx, types.KeyCommunityInvite(id, nil)) {
+		k.deleteCommunityInvite(ctx, id, invite.CodeHash)
+	}
+}
+
+// GetCommunityInvites returns the invite codes of every community
+func (k Keeper) GetCommunityInvites(ctx sdk.Context) []types.CommunityInvite {
+	return k.getCommunityInvites(ctx, types.PrefixCommunityInvite)
+}
+
+func (k Keeper) getCommunityInvites(ctx sdk.Context, prefix []byte) (invites []types.CommunityInvite) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var invite types.CommunityInvite
+		k.cdc.MustUnmarshal(iterator.Value(), &invite)
+		invites = append(invites, invite)
+	}
+	return invites
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestJoinOpenCommunity() {
+	community := suite.createCommunity("community")
+
+	pending, err := suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().NoError(err)
+	suite.False(pending)
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().Error(err)
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address, "")
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperSuite) TestJoinApprovalCommunity() {
+	community := suite.createCommunity("community")
+	err := suite.keeper.SetMembershipPolicy(suite.ctx, community.Id, types.PolicyApproval, "", address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.SetMembershipPolicy(suite.ctx, community.Id, types.PolicyApproval, "", address))
+
+	pending, err := suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().NoError(err)
+	suite.True(pending)
+	suite.Equal(types.RoleNone, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().ErrorIs(err, types.ErrMembership)
+
+	// plain members cannot approve requests
+	suite.Require().ErrorIs(suite.keeper.ApproveJoinRequest(suite.ctx, community.Id, address3, false, address2), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.ApproveJoinRequest(suite.ctx, community.Id, address3, false, address))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address4, "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.ApproveJoinRequest(suite.ctx, community.Id, address4, true, address))
+	suite.Equal(types.RoleNone, suite.keeper.GetCommunityRole(suite.ctx, community, address4))
+	suite.Require().ErrorIs(suite.keeper.ApproveJoinRequest(suite.ctx, community.Id, address4, false, address), types.ErrMembership)
+}
+
+func (suite *KeeperSuite) TestJoinInviteCommunity() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.SetMembershipPolicy(suite.ctx, community.Id, types.PolicyInvite, "", address))
+
+	err := suite.keeper.CreateCommunityInvite(suite.ctx, community.Id, types.InviteCodeHash("secret"), 1, address2)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.CreateCommunityInvite(suite.ctx, community.Id, types.InviteCodeHash("secret"), 1, address))
+
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().ErrorIs(err, types.ErrMembership)
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "wrong")
+	suite.Require().ErrorIs(err, types.ErrMembership)
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "secret")
+	suite.Require().NoError(err)
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+
+	// the invite was used up
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address4, "secret")
+	suite.Require().ErrorIs(err, types.ErrMembership)
+	suite.Empty(suite.keeper.GetCommunityInvites(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestJoinTokenGatedCommunity() {
+	community := suite.createCommunity("community")
+	err := suite.keeper.SetMembershipPolicy(suite.ctx, community.Id, types.PolicyTokenGated, "unknown", address)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+	suite.Require().NoError(suite.keeper.SetMembershipPolicy(suite.ctx, community.Id, types.PolicyTokenGated, denomID, address))
+
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().ErrorIs(err, types.ErrMembership)
+
+	suite.mintNFT(denomID, tokenID, "0", address3, address)
+	_, err = suite.keeper.JoinCommunity(suite.ctx, community.Id, address3, "")
+	suite.Require().NoError(err)
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+}
+
+func (suite *KeeperSuite) TestLeaveCommunity() {
+	community := suite.createCommunity("community")
+
+	suite.Require().ErrorIs(suite.keeper.LeaveCommunity(suite.ctx, community.Id, address), types.ErrMembership)
+	suite.Require().ErrorIs(suite.keeper.LeaveCommunity(suite.ctx, community.Id, address3), types.ErrMembership)
+	suite.Require().NoError(suite.keeper.LeaveCommunity(suite.ctx, community.Id, address2))
+	suite.Equal(types.RoleNone, suite.keeper.GetCommunityRole(suite.ctx, community, address2))
+	suite.Empty(suite.keeper.GetAllCommunityMembers(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestRemoveMember() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address3, types.RoleModerator, address))
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address4, types.RoleModerator, address))
+
+	// moderators only remove members with a lower role
+	suite.Require().ErrorIs(suite.keeper.RemoveMember(suite.ctx, community.Id, address4, address3), types.ErrUnauthorized)
+	suite.Require().ErrorIs(suite.keeper.RemoveMember(suite.ctx, community.Id, address3, address2), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.RemoveMember(suite.ctx, community.Id, address2, address3))
+	suite.Equal(types.RoleNone, suite.keeper.GetCommunityRole(suite.ctx, community, address2))
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from the layout of the first release. The single royalty of every
+// nft moves to a royalty share paid to its creator, denoms are indexed by their creator and their
+// community and the member list of every community moves to one key per member. The module
+// parameters, which the first release did not have, are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, denom := range m.keeper.GetDenoms(ctx) {
+		store.Set(types.KeyDenomCreator(denom.Creator, denom.Id), []byte{})
+		if len(denom.CommunityId) > 0 {
+			store.Set(types.KeyCommunityDenom(denom.CommunityId, denom.Id), []byte{})
+		}
+
+		if err := m.migrateRoyalties(ctx, denom.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, community := range m.keeper.GetCommunities(ctx) {
+		if err := m.migrateCommunityMembers(ctx, community); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateRoyalties moves the single royalty of every nft of a denom to a royalty share paid to its creator.
+func (m Migrator) migrateRoyalties(ctx sdk.Context, denomID string) error {
+	for _, nft := range m.keeper.GetNFTs(ctx, denomID) {
+		baseNFT := nft.(types.NFT)
+		if len(baseNFT.RoyaltyShares) > 0 || len(baseNFT.Royalties) == 0 {
+			continue
+		}
+
+		royalty, err := sdk.NewDecFromStr(baseNFT.Royalties)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidNFT, "unable to parse the royalities of nft %s: %s", baseNFT.Id, err.Error())
+		}
+
+		if royalty.IsPositive() {
+			baseNFT.RoyaltyShares = []types.RoyaltyShare{types.NewRoyaltyShare(baseNFT.GetCreator(), royalty)}
+			m.keeper.SetNFT(ctx, denomID, baseNFT)
+		}
+	}
+	return nil
+}
+
+// migrateCommunityMembers moves the member list of a community to one key per member.
+func (m Migrator) migrateCommunityMembers(ctx sdk.Context, community types.Community) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	bz := store.Get(types.KeyCommunityMembers(community.Id))
+	if bz == nil {
+		return nil
+	}
+
+	var cm types.CommunityMembers
+	m.keeper.cdc.MustUnmarshal(bz, &cm)
+	for _, address := range cm.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member %s of community %s", address, community.Id)
+		}
+
+		if strings.EqualFold(address, community.Creator) || m.keeper.isCommunityMember(ctx, community.Id, addr) {
+			continue
+		}
+		m.keeper.addCommunityMember(ctx, community.Id, addr)
+	}
+	store.Delete(types.KeyCommunityMembers(community.Id))
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AutonomyNetwork/nft"
+	"github.com/AutonomyNetwork/nft/keeper"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestMigrate1to2() {
+	// nfts stored before royalty shares only have a single royalty
+	legacy := types.NewBaseNFT(tokenID, types.Metadata{Name: tokenNm}, address2, true, "0.2", address, blockTime, tokenData)
+	suite.keeper.SetNFT(suite.ctx, denomID, legacy)
+	free := types.NewBaseNFT(tokenID2, types.Metadata{Name: tokenNm2}, address2, true, "0", address, blockTime, tokenData)
+	suite.keeper.SetNFT(suite.ctx, denomID, free)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal([]types.RoyaltyShare{types.NewRoyaltyShare(address, sdk.NewDecWithPrec(2, 1))}, nft.(types.NFT).RoyaltyShares)
+
+	nft, err = suite.keeper.GetNFT(suite.ctx, denomID, tokenID2)
+	suite.Require().NoError(err)
+	suite.Empty(nft.(types.NFT).RoyaltyShares)
+}
+
+func (suite *KeeperSuite) TestMigrate1to2DenomCreators() {
+	// denoms of the first release are not indexed by their creator
+	store := suite.ctx.KVStore(suite.storeKey)
+	for _, id := range []string{denomID, denomID2, denomID3} {
+		store.Delete(types.KeyDenomCreator(address.String(), id))
+	}
+
+	denoms, err := suite.keeper.GetDenomsByCreator(suite.ctx, address.String())
+	suite.Require().NoError(err)
+	suite.Empty(denoms)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	denoms, err = suite.keeper.GetDenomsByCreator(suite.ctx, address.String())
+	suite.Require().NoError(err)
+	suite.Len(denoms, 3)
+}
+
+func (suite *KeeperSuite) TestMigrate1to2CommunityDenoms() {
+	community := types.Community{Id: "community", Name: "community", Creator: address.String(), VotingWeight: types.WeightNFT}
+	suite.Require().NoError(suite.keeper.SetCommunity(suite.ctx, community))
+
+	err := suite.keeper.CreateDenom(suite.ctx, "communitydenom", "communitydenom", "communitydenom", "", "", address.String(), community.Id,
+		nil, "", false, 0, 0, "", types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+	suite.mintNFT("communitydenom", tokenID, "0", address2, address)
+	suite.mintNFT("communitydenom", tokenID2, "0", address2, address)
+
+	// denoms of the first release are not indexed by their community
+	store := suite.ctx.KVStore(suite.storeKey)
+	store.Delete(types.KeyCommunityDenom(community.Id, "communitydenom"))
+	suite.Zero(suite.keeper.GetVotingPower(suite.ctx, community, address2))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	suite.Equal(uint64(2), suite.keeper.GetVotingPower(suite.ctx, community, address2))
+}
+
+func (suite *KeeperSuite) TestMigrate1to2CommunityMembers() {
+	community := types.Community{Id: "community", Name: "community", Creator: address.String()}
+	suite.Require().NoError(suite.keeper.SetCommunity(suite.ctx, community))
+
+	// communities of the first release keep their members in a single list
+	store := suite.ctx.KVStore(suite.storeKey)
+	legacy := types.CommunityMembers{
+		CommunityId: community.Id,
+		Addresses:   []string{address.String(), address2.String(), address3.String()},
+	}
+	store.Set(types.KeyCommunityMembers(community.Id), suite.cdc.MustMarshal(&legacy))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	suite.False(store.Has(types.KeyCommunityMembers(community.Id)))
+	suite.Equal(types.RoleOwner, suite.keeper.GetCommunityRole(suite.ctx, community, address))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address2))
+	suite.Equal(types.RoleMember, suite.keeper.GetCommunityRole(suite.ctx, community, address3))
+	// the owner is not stored as a member
+	suite.Len(suite.keeper.GetAllCommunityMembers(suite.ctx), 2)
+}
+
+func (suite *KeeperSuite) TestMigrate1to2Params() {
+	// the first release has no module parameters
+	k := keeper.NewKeeper(suite.cdc, suite.storeKey, suite.accKeeper, suite.bankKeeper, suite.paramsKeeper.Subspace("legacy"),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	suite.Panics(func() { k.FilledOrderRetention(suite.ctx) })
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate1to2(suite.ctx))
+
+	suite.Equal(types.DefaultParams().MaxRoyalty, k.GetParams(suite.ctx).MaxRoyalty)
+	suite.Zero(k.FilledOrderRetention(suite.ctx))
+	suite.NotPanics(func() { nft.EndBlocker(suite.ctx, k) })
+}
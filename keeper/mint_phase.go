@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// SetMintPhases replaces the mint phases of a primary sale denom. The minted count of a phase
+// is kept when it is replaced by a phase with the same id.
+func (k Keeper) SetMintPhases(ctx sdk.Context, denomID string, phases []types.MintPhase, sender sdk.AccAddress) error {
+	denom, err := k.authorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+
+	if !denom.PrimarySale {
+		return sdkerrors.Wrapf(types.ErrInvalidMintPhase, "denom %s is not a primary sale", denomID)
+	}
+
+	for _, phase := range phases {
+		price, err := phase.GetPrice()
+		if err != nil {
+			return err
+		}
+
+		if len(phase.Price) > 0 {
+			if err := k.validatePaymentDenom(ctx, price.Denom); err != nil {
+				return err
+			}
+		}
+	}
+
+	minted := make(map[uint64]uint64)
+	for _, phase := range k.GetMintPhases(ctx, denomID) {
+		minted[phase.Id] = phase.Minted
+		k.deleteMintPhase(ctx, denomID, phase.Id)
+	}
+
+	for _, phase := range phases {
+		phase.DenomId = denomID
+		phase.Minted = minted[phase.Id]
+		k.SetMintPhase(ctx, phase)
+	}
+	return nil
+}
+
+// SetAllowlist adds addresses to the allowlist of a mint phase and replaces its merkle root when one is given
+func (k Keeper) SetAllowlist(ctx sdk.Context, denomID string, phaseID uint64, addresses []sdk.AccAddress, merkleRoot []byte, sender sdk.AccAddress) error {
+	if _, err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+
+	phase, err := k.GetMintPhase(ctx, denomID, phaseID)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		k.SetAllowlisted(ctx, denomID, phaseID, address)
+	}
+
+	if len(merkleRoot) > 0 {
+		phase.MerkleRoot = merkleRoot
+		k.SetMintPhase(ctx, phase)
+	}
+	return nil
+}
+
+func (k Keeper) authorizeDenomCreator(ctx sdk.Context, denomID string, sender sdk.AccAddress) (types.Denom, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.Denom{}, err
+	}
+
+	if denom.Creator != sender.String() {
+		return types.Denom{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the creator of denom %s", sender, denomID)
+	}
+	return denom, nil
+}
+
+// checkMintPhase returns the active mint phase of a denom after checking the minter is allowed to mint in it
+func (k Keeper) checkMintPhase(ctx sdk.Context, denomID string, phases []types.MintPhase, minter sdk.AccAddress, merkleProof [][]byte) (types.MintPhase, error) {
+	for _, phase := range phases {
+		if !phase.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		if phase.IsSoldOut() {
+			return phase, sdkerrors.Wrapf(types.ErrMintLimit, "phase %d of denom %s is sold out", phase.Id, denomID)
+		}
+
+		if phase.WalletLimit > 0 && k.GetWalletMints(ctx, denomID, phase.Id, minter) >= phase.WalletLimit {
+			return phase, sdkerrors.Wrapf(types.ErrMintLimit, "%s minted %d nfts in phase %d", minter, phase.WalletLimit, phase.Id)
+		}
+
+		if phase.AllowlistOnly && !k.IsAllowlisted(ctx, denomID, phase.Id, minter) &&
+			!(len(phase.MerkleRoot) > 0 && types.VerifyMerkleProof(phase.MerkleRoot, types.MerkleLeaf(minter), merkleProof)) {
+			return phase, sdkerrors.Wrapf(types.ErrNotAllowlisted, "%s is not allowlisted in phase %d", minter, phase.Id)
+		}
+		return phase, nil
+	}
+	return types.MintPhase{}, sdkerrors.Wrapf(types.ErrNoActivePhase, "denom %s has no active mint phase", denomID)
+}
+
+// recordPhaseMint counts a mint against the supply of the phase and the limit of the minter
+func (k Keeper) recordPhaseMint(ctx sdk.Context, phase types.MintPhase, minter sdk.AccAddress) {
+	phase.Minted++
+	k.SetMintPhase(ctx, phase)
+	k.SetWalletMints(ctx, phase.DenomId, phase.Id, minter, k.GetWalletMints(ctx, phase.DenomId, phase.Id, minter)+1)
+}
+
+func (k Keeper) SetMintPhase(ctx sdk.Context, phase types.MintPhase) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&phase)
+	store.Set(types.KeyMintPhase(phase.DenomId, phase.Id), bz)
+}
+
+func (k Keeper) GetMintPhase(ctx sdk.Context, denomID string, phaseID uint64) (types.MintPhase, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyMintPhase(denomID, phaseID))
+	if bz == nil {
+		return types.MintPhase{}, sdkerrors.Wrapf(types.ErrInvalidMintPhase, "denom %s has no phase %d", denomID, phaseID)
+	}
+
+	var phase types.MintPhase
+	k.cdc.MustUnmarshal(bz, &phase)
+	return phase, nil
+}
+
+// GetMintPhases returns the mint phases of a denom ordered by id
+func (k Keeper) GetMintPhases(ctx sdk.Context, denomID string) (phases []types.MintPhase) {
+	return k.getMintPhases(ctx, types.KeyMintPhase(denomID, 0))
+}
+
+// GetAllMintPhases returns the mint phases of every denom
+func (k Keeper) GetAllMintPhases(ctx sdk.Context) (phases []types.MintPhase) {
+	return k.getMintPhases(ctx, types.PrefixMintPhase)
+}
+
+func (k Keeper) getMintPhases(ctx sdk.Context, prefix []byte) (phases []types.MintPhase) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var phase types.MintPhase
+		k.cdc.MustUnmarshal(iterator.Value(), &phase)
+		phases = append(phases, phase)
+	}
+	return phases
+}
+
+func (k Keeper) deleteMintPhase(ctx sdk.Context, denomID string, phaseID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMintPhase(denomID, phaseID))
+}
+
+func (k Keeper) SetAllowlisted(ctx sdk.Context, denomID string, phaseID uint64, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAllowlist(denomID, phaseID, address), []byte{})
+}
+
+func (k Keeper) IsAllowlisted(ctx sdk.Context, denomID string, phaseID uint64, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyAllowlist(denomID, phaseID, address))
+}
+
+// GetAllowlists returns the allowlisted addresses of every mint phase
+func (k Keeper) GetAllowlists(ctx sdk.Context) (allowlists []types.PhaseAllowlist) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixAllowlist)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, phaseID, address, err := types.SplitKeyPhaseAddress(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		last := len(allowlists) - 1
+		if last < 0 || allowlists[last].DenomId != denomID || allowlists[last].PhaseId != phaseID {
+			allowlists = append(allowlists, types.PhaseAllowlist{DenomId: denomID, PhaseId: phaseID})
+			last++
+		}
+		allowlists[last].Addresses = append(allowlists[last].Addresses, address.String())
+	}
+	return allowlists
+}
+
+func (k Keeper) SetWalletMints(ctx sdk.Context, denomID string, phaseID uint64, address sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyWalletMints(denomID, phaseID, address), sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) GetWalletMints(ctx sdk.Context, denomID string, phaseID uint64, address sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyWalletMints(denomID, phaseID, address))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllWalletMints returns the number of nfts every wallet minted in every mint phase
+func (k Keeper) GetAllWalletMints(ctx sdk.Context) (mints []types.WalletMints) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixWalletMints)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, phaseID, address, err := types.SplitKeyPhaseAddress(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		mints = append(mints, types.WalletMints{
+			DenomId: denomID,
+			PhaseId: phaseID,
+			Address: address.String(),
+			Count:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return mints
+}
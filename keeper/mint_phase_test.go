@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// merkleRoot returns the root of a two leaf allowlist tree
+func merkleRoot(a, b sdk.AccAddress) []byte {
+	leafA, leafB := types.MerkleLeaf(a), types.MerkleLeaf(b)
+	root := sha256.Sum256(append(append([]byte{}, leafB...), leafA...))
+	if string(leafA) < string(leafB) {
+		root = sha256.Sum256(append(append([]byte{}, leafA...), leafB...))
+	}
+	return root[:]
+}
+
+func (suite *KeeperSuite) TestVerifyMerkleProof() {
+	root := merkleRoot(address3, address4)
+
+	suite.True(types.VerifyMerkleProof(root, types.MerkleLeaf(address3), [][]byte{types.MerkleLeaf(address4)}))
+	suite.True(types.VerifyMerkleProof(root, types.MerkleLeaf(address4), [][]byte{types.MerkleLeaf(address3)}))
+
+	suite.False(types.VerifyMerkleProof(root, types.MerkleLeaf(address2), [][]byte{types.MerkleLeaf(address4)}))
+	suite.False(types.VerifyMerkleProof(root, types.MerkleLeaf(address3), nil))
+	suite.False(types.VerifyMerkleProof(root, types.MerkleLeaf(address3), [][]byte{types.MerkleLeaf(address2)}))
+}
+
+func (suite *KeeperSuite) TestMintPrimarySaleMerklePhase() {
+	denom := suite.createPrimarySale("saledenom", 10, 100)
+	phases := []types.MintPhase{{
+		Id:            1,
+		StartTime:     blockTime,
+		EndTime:       blockTime.Add(time.Hour),
+		Supply:        5,
+		AllowlistOnly: true,
+		MerkleRoot:    merkleRoot(address3, address4),
+	}}
+	suite.Require().NoError(suite.keeper.SetMintPhases(suite.ctx, denom.Id, phases, address))
+	suite.fund(address2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	metadata := types.Metadata{Name: tokenNm}
+	_, err := suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID, true, address2, metadata, tokenData, [][]byte{types.MerkleLeaf(address4)})
+	suite.Require().ErrorIs(err, types.ErrNotAllowlisted)
+
+	// a phase without a price charges the price of the denom
+	sale, err := suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID, true, address3, metadata, tokenData, [][]byte{types.MerkleLeaf(address4)})
+	suite.Require().NoError(err)
+	suite.Equal("100stake", sale.Price)
+	suite.True(suite.balance(address3).IsZero())
+	suite.Equal(sdk.NewInt(100), suite.balance(address))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denom.Id, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+	suite.Equal(address, nft.GetCreator())
+
+	phase, err := suite.keeper.GetMintPhase(suite.ctx, denom.Id, 1)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), phase.Minted)
+	suite.Equal(uint64(1), suite.keeper.GetWalletMints(suite.ctx, denom.Id, 1, address3))
+}
+
+func (suite *KeeperSuite) TestMintPrimarySalePhasePrice() {
+	denom := suite.createPrimarySale("saledenom", 10, 100)
+	phases := []types.MintPhase{
+		{Id: 1, StartTime: blockTime, EndTime: blockTime.Add(time.Hour), Price: "0stake"},
+		{Id: 2, StartTime: blockTime.Add(time.Hour), EndTime: blockTime.Add(2 * time.Hour), Price: "40stake"},
+	}
+	suite.Require().NoError(suite.keeper.SetMintPhases(suite.ctx, denom.Id, phases, address))
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	metadata := types.Metadata{Name: tokenNm}
+	sale, err := suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID, true, address3, metadata, tokenData, nil)
+	suite.Require().NoError(err)
+	suite.Empty(sale.Price)
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	sale, err = suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID2, true, address3, metadata, tokenData, nil)
+	suite.Require().NoError(err)
+	suite.Equal("40stake", sale.Price)
+	suite.Equal(sdk.NewInt(60), suite.balance(address3))
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	_, err = suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID3, true, address3, metadata, tokenData, nil)
+	suite.Require().ErrorIs(err, types.ErrNoActivePhase)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// GrantMinter allows the minter to mint into a non primary sale denom of the sender. The nfts
+// already minted by an existing minter still count against its new quota.
+func (k Keeper) GrantMinter(ctx sdk.Context, denomID string, minter sdk.AccAddress, quota uint64, expiresAt *time.Time, sender sdk.AccAddress) error {
+	denom, err := k.authorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+
+	if denom.PrimarySale {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "primary sale denom %s is minted by buyers", denomID)
+	}
+
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidDuration, "minter expiry %s is in the past", expiresAt)
+	}
+
+	grant := types.NewMinter(denomID, minter, quota, expiresAt)
+	if existing, found := k.GetMinter(ctx, denomID, minter); found {
+		grant.Minted = existing.Minted
+	}
+	k.SetMinter(ctx, grant)
+	return nil
+}
+
+// RevokeMinter removes the mint rights of the minter on a denom of the sender
+func (k Keeper) RevokeMinter(ctx sdk.Context, denomID string, minter, sender sdk.AccAddress) error {
+	if _, err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+
+	if _, found := k.GetMinter(ctx, denomID, minter); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownMinter, "%s is not a minter of denom %s", minter, denomID)
+	}
+	k.deleteMinter(ctx, denomID, minter)
+	return nil
+}
+
+// useMinter checks the minter is allowed to mint into the denom and counts the mint against its quota
+func (k Keeper) useMinter(ctx sdk.Context, denomID string, minter sdk.AccAddress) error {
+	grant, found := k.GetMinter(ctx, denomID, minter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s don't have access to mint nft in %s collection", minter, denomID)
+	}
+
+	if grant.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "mint rights of %s in %s collection expired at %s", minter, denomID, grant.ExpiresAt)
+	}
+
+	if grant.IsQuotaReached() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s minted its quota of %d nfts in %s collection", minter, grant.Quota, denomID)
+	}
+
+	grant.Minted++
+	k.SetMinter(ctx, grant)
+	return nil
+}
+
+func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&minter)
+	store.Set(types.KeyMinter(minter.DenomId, minter.GetAddress()), bz)
+}
+
+func (k Keeper) GetMinter(ctx sdk.Context, denomID string, address sdk.AccAddress) (types.Minter, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyMinter(denomID, address))
+	if bz == nil {
+		return types.Minter{}, false
+	}
+
+	var minter types.Minter
+	k.cdc.MustUnmarshal(bz, &minter)
+	return minter, true
+}
+
+func (k Keeper) deleteMinter(ctx sdk.Context, denomID string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMinter(denomID, address))
+}
+
+// GetMinters returns the minters of a denom
+func (k Keeper) GetMinters(ctx sdk.Context, denomID string) []types.Minter {
+	return k.getMinters(ctx, types.KeyMinter(denomID, nil))
+}
+
+// GetAllMinters returns the minters of every denom
+func (k Keeper) GetAllMinters(ctx sdk.Context) []types.Minter {
+	return k.getMinters(ctx, types.PrefixMinter)
+}
+
+func (k Keeper) getMinters(ctx sdk.Context, prefix []byte) (minters []types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshal(iterator.Value(), &minter)
+		minters = append(minters, minter)
+	}
+	return minters
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// mintAs mints an nft of the denom through the msg server as the minter
+func (suite *KeeperSuite) mintAs(denomID, tokenID string, minter sdk.AccAddress) error {
+	msg := &types.MsgMintNFT{Id: tokenID, DenomId: denomID, Transferable: true, Creator: minter.String(), Royalties: "0"}
+	_, err := suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), msg)
+	return err
+}
+
+func (suite *KeeperSuite) TestGrantMinter() {
+	suite.Require().ErrorIs(suite.mintAs(denomID, tokenID, address2), types.ErrUnauthorized)
+
+	// only the denom creator grants minters
+	suite.Require().ErrorIs(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 2, nil, address3), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 2, nil, address))
+
+	suite.Require().NoError(suite.mintAs(denomID, tokenID, address2))
+	suite.Require().NoError(suite.mintAs(denomID, tokenID2, address2))
+	suite.Require().ErrorIs(suite.mintAs(denomID, tokenID3, address2), types.ErrUnauthorized)
+
+	// the nfts already minted count against the new quota
+	suite.Require().NoError(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 3, nil, address))
+	minter, found := suite.keeper.GetMinter(suite.ctx, denomID, address2)
+	suite.Require().True(found)
+	suite.Equal(uint64(2), minter.Minted)
+	suite.Require().NoError(suite.mintAs(denomID, tokenID3, address2))
+
+	// the creator mints without a grant
+	suite.Require().NoError(suite.mintAs(denomID, "creatortoken", address))
+	suite.Equal(uint64(3), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+}
+
+func (suite *KeeperSuite) TestMinterExpiry() {
+	past := blockTime.Add(-time.Hour)
+	suite.Require().ErrorIs(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 0, &past, address), types.ErrInvalidDuration)
+
+	expiresAt := blockTime.Add(time.Hour)
+	suite.Require().NoError(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 0, &expiresAt, address))
+	suite.Require().NoError(suite.mintAs(denomID, tokenID, address2))
+
+	suite.ctx = suite.ctx.WithBlockTime(expiresAt)
+	suite.Require().ErrorIs(suite.mintAs(denomID, tokenID2, address2), types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestRevokeMinter() {
+	suite.Require().ErrorIs(suite.keeper.RevokeMinter(suite.ctx, denomID, address2, address), types.ErrUnknownMinter)
+
+	suite.Require().NoError(suite.keeper.GrantMinter(suite.ctx, denomID, address2, 0, nil, address))
+	suite.Require().ErrorIs(suite.keeper.RevokeMinter(suite.ctx, denomID, address2, address2), types.ErrUnauthorized)
+	suite.Require().NoError(suite.keeper.RevokeMinter(suite.ctx, denomID, address2, address))
+
+	suite.Empty(suite.keeper.GetMinters(suite.ctx, denomID))
+	suite.Require().ErrorIs(suite.mintAs(denomID, tokenID, address2), types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestGrantMinterPrimarySale() {
+	suite.createPrimarySale("saledenom", 10, 100)
+	err := suite.keeper.GrantMinter(suite.ctx, "saledenom", address2, 0, nil, address)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+}
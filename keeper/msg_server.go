@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"reflect"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,10 +40,15 @@ func (m msgServer) CreateDenom(goCtx context.Context,
 	}
 
 	// check community exist
-	if !m.HasCommunity(ctx, msg.CommunityId) {
+	community, found := m.GetCommunityByID(ctx, msg.CommunityId)
+	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "%s, community not exist", id)
 	}
 
+	if community.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s is archived", msg.CommunityId)
+	}
+
 	access := m.AuthorizedCommunityMember(ctx, msg.CommunityId, collectionCreator)
 	if !(access) {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s, doesn't have access to create collection", msg.Creator)
@@ -52,9 +56,9 @@ func (m msgServer) CreateDenom(goCtx context.Context,
 
 	// Check is there any dependent collection
 	if len(msg.DepedentCollection) != 0 {
-		for _, id := range msg.DepedentCollection {
-			if !m.HasCommunity(ctx, id) {
-				return nil, sdkerrors.Wrapf(types.ErrUnknownCollection, "%s, dependent collection not found", id)
+		for _, dependentID := range msg.DepedentCollection {
+			if dependentID == id || !m.HasDenomID(ctx, dependentID) {
+				return nil, sdkerrors.Wrapf(types.ErrUnknownCollection, "%s, dependent collection not found", dependentID)
 			}
 		}
 	}
@@ -69,6 +73,14 @@ func (m msgServer) CreateDenom(goCtx context.Context,
 		paymentInfo.AccessType = msg.AccessType
 		paymentInfo.Amount = msg.Amount
 		paymentInfo.Currency = msg.Currency
+
+		if err := m.validatePaymentInfo(ctx, paymentInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.chargeDenomCreationFee(ctx, collectionCreator); err != nil {
+		return nil, err
 	}
 
 	if err := m.Keeper.CreateDenom(ctx,
@@ -86,6 +98,8 @@ func (m msgServer) CreateDenom(goCtx context.Context,
 		msg.AvailableNfts,
 		msg.Data,
 		paymentInfo,
+		msg.RoyaltyShares,
+		msg.TokenGate,
 	); err != nil {
 		return nil, err
 	}
@@ -116,44 +130,59 @@ func (m msgServer) MintNFT(goCtx context.Context,
 		return nil, sdkerrors.Wrapf(types.ErrInvalidCollection, "%s collection", msg.DenomId)
 	}
 
-	if denom.PrimarySale == false && strings.EqualFold(denom.Creator, msg.Creator) {
-		owner, _ = sdk.AccAddressFromBech32(denom.Creator)
-		// return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s don't have access to mint nft in %s collection", msg.Creator, denom.Id)
+	if denom.Badge {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidBadge, "badges of denom %s are issued by the community admins", msg.DenomId)
+	}
+
+	if denom.PrimarySale == false {
+		// the denom creator can always mint, other addresses need a minter grant
+		if !strings.EqualFold(denom.Creator, msg.Creator) {
+			if err := m.useMinter(ctx, denom.Id, creator); err != nil {
+				return nil, err
+			}
+		}
+		owner = creator
+		denomCreator, _ := sdk.AccAddressFromBech32(denom.Creator)
+		if err := m.useTokenGate(ctx, denom, creator); err != nil {
+			return nil, err
+		}
 		if err := m.Keeper.MintNFT(ctx,
 			msg.DenomId,
 			msg.Id,
 			msg.Royalties,
 			msg.Transferable,
 			owner,
-			creator,
+			denomCreator,
 			msg.Metadata,
 			msg.Data,
+			msg.RoyaltyShares,
 		); err != nil {
 			return nil, err
 		}
 	}
 
 	if denom.PrimarySale == true {
-		if denom.AvailableNfts == 0 {
-			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "not enough nfts in %s collection to mint", denom.Id)
+		if err := m.useTokenGate(ctx, denom, creator); err != nil {
+			return nil, err
 		}
-		owner, _ = sdk.AccAddressFromBech32(msg.Creator)
-		if err := m.Keeper.MintNFT(ctx,
-			msg.DenomId,
+		sale, err := m.Keeper.MintPrimarySale(ctx,
+			denom,
 			msg.Id,
-			msg.Royalties,
 			msg.Transferable,
-			owner,
 			creator,
 			msg.Metadata,
 			msg.Data,
-		); err != nil {
+			msg.MerkleProof,
+		)
+		if err != nil {
 			return nil, err
 		}
 		denom.AvailableNfts = denom.AvailableNfts - 1
+
+		ctx.EventManager().EmitTypedEvent(&sale)
 	}
 
-	m.Keeper.SetDenom(ctx, denom)
+	m.Keeper.updateDenom(ctx, denom)
 
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventMintNFT{
@@ -244,11 +273,11 @@ func (m msgServer) SellNFT(goCtx context.Context,
 	}
 
 	if msg.ListedType == types.Crypto {
-		if err := m.Keeper.SellNFT(ctx, msg.Id, msg.DenomId, msg.Price, seller); err != nil {
+		if err := m.Keeper.SellNFT(ctx, msg.Id, msg.DenomId, msg.Price, seller, msg.ExpiresAt); err != nil {
 			return nil, err
 		}
 	} else if msg.ListedType == types.Fiat {
-		if err := m.Keeper.SellNFTWithFiat(ctx, msg.Id, msg.DenomId, msg.Currency, msg.FiatAmount, seller); err != nil {
+		if err := m.Keeper.SellNFTWithFiat(ctx, msg.Id, msg.DenomId, msg.Currency, msg.FiatAmount, seller, msg.ExpiresAt); err != nil {
 			return nil, err
 		}
 	} else {
@@ -301,6 +330,10 @@ func (m msgServer) CreateCommunity(goCtx context.Context, msg *types.MsgCreateCo
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if m.Keeper.HasCommunity(ctx, msg.Id) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s already exists", msg.Id)
+	}
+
 	community := types.Community{
 		Name:        msg.Name,
 		Id:          msg.Id,
@@ -327,27 +360,26 @@ func (m msgServer) CreateCommunity(goCtx context.Context, msg *types.MsgCreateCo
 }
 
 func (m msgServer) JoinCommunity(goCtx context.Context, msg *types.MsgJoinCommunity) (*types.MsgJoinCommunityResponse, error) {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	if !m.HasCommunity(ctx, msg.CommunityId) {
-		return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", msg.CommunityId)
+	pending, err := m.Keeper.JoinCommunity(ctx, msg.CommunityId, address, msg.InviteCode)
+	if err != nil {
+		return nil, err
 	}
 
-	cm, err := m.GetCommunityMembers(ctx, msg.CommunityId)
-	if reflect.DeepEqual(cm, types.CommunityMembers{}) && err == nil {
-		cm.CommunityId = msg.CommunityId
-		cm.Addresses = append(cm.Addresses, msg.Address)
-	} else {
-		for _, address := range cm.Addresses {
-			if strings.EqualFold(address, msg.Address) {
-				return nil, sdkerrors.Wrapf(types.ErrCommunityNotFound, "address already exist")
-			}
-		}
-		cm.Addresses = append(cm.Addresses, msg.Address)
+	if pending {
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventJoinRequest{
+				Id:      msg.CommunityId,
+				Address: msg.Address,
+			})
+		return &types.MsgJoinCommunityResponse{}, nil
 	}
 
-	m.SetCommunityMembers(ctx, cm)
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventJoinCommunity{
 			Id:      msg.CommunityId,
@@ -411,20 +443,1066 @@ func (m msgServer) DeleteMarketPlaceNFT(goCtx context.Context, msg *types.MsgDel
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	nft, err := m.Keeper.GetNFT(ctx, msg.DenomId, msg.NftId)
+	seller, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", msg.Address)
+	}
+
+	if err := m.Keeper.DelistNFT(ctx, msg.DenomId, msg.NftId, seller); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteMarketPlaceNFTResponse{}, nil
+}
+
+func (m msgServer) BurnNFT(goCtx context.Context, msg *types.MsgBurnNFT) (*types.MsgBurnNFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
-	order, err := m.Keeper.GetMarketPlaceNFT(ctx, msg.DenomId, msg.NftId)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.BurnNFT(ctx, msg.DenomId, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventBurnNFT{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Owner:   msg.Sender,
+		},
+	)
+
+	return &types.MsgBurnNFTResponse{}, nil
+}
+
+func (m msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
 	if err != nil {
 		return nil, err
 	}
 
-	if nft.GetOwner().String() != msg.Address || msg.Address != order.GetSeller().String() {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is unathorized to perform this operation", msg.Address)
+	startPrice, err := sdk.ParseCoinNormalized(msg.StartPrice)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidBid, "invalid start price %s", err.Error())
 	}
 
-	m.Keeper.DeleteMarketPlaceNFT(ctx, msg.DenomId, msg.NftId)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auction, err := m.Keeper.CreateAuction(ctx, msg.Id, msg.DenomId, startPrice, msg.Duration, seller)
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.MsgDeleteMarketPlaceNFTResponse{}, nil
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateAuction{
+			Id:         msg.Id,
+			DenomId:    msg.DenomId,
+			StartPrice: auction.StartPrice,
+			Seller:     msg.Seller,
+			EndTime:    auction.EndTime.String(),
+		},
+	)
+
+	return &types.MsgCreateAuctionResponse{}, nil
+}
+
+func (m msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidBid, "invalid bid amount %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.PlaceBid(ctx, msg.Id, msg.DenomId, amount, bidder); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventPlaceBid{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Amount:  amount.String(),
+			Bidder:  msg.Bidder,
+		},
+	)
+
+	return &types.MsgPlaceBidResponse{}, nil
+}
+
+func (m msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelAuction(ctx, msg.Id, msg.DenomId, seller); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelAuction{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Seller:  msg.Seller,
+		},
+	)
+
+	return &types.MsgCancelAuctionResponse{}, nil
+}
+
+func (m msgServer) CreateDutchAuction(goCtx context.Context, msg *types.MsgCreateDutchAuction) (*types.MsgCreateDutchAuctionResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDutchAuctionPrices(msg.StartPrice, msg.FloorPrice, msg.DecayAmount); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.Keeper.CreateDutchAuction(ctx, msg.Id, msg.DenomId, msg.StartPrice, msg.FloorPrice, msg.DecayAmount, msg.DecayInterval, seller); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateDutchAuction{
+			Id:            msg.Id,
+			DenomId:       msg.DenomId,
+			StartPrice:    msg.StartPrice,
+			FloorPrice:    msg.FloorPrice,
+			DecayAmount:   msg.DecayAmount,
+			DecayInterval: msg.DecayInterval.String(),
+			Seller:        msg.Seller,
+		},
+	)
+
+	return &types.MsgCreateDutchAuctionResponse{}, nil
+}
+
+func (m msgServer) MakeOffer(goCtx context.Context, msg *types.MsgMakeOffer) (*types.MsgMakeOfferResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOffer, "invalid offer amount %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.Keeper.MakeOffer(ctx, msg.Id, msg.DenomId, amount, msg.ExpiresAt, bidder); err != nil {
+		return nil, err
+	}
+
+	var expiresAt string
+	if msg.ExpiresAt != nil {
+		expiresAt = msg.ExpiresAt.String()
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventMakeOffer{
+			Id:        msg.Id,
+			DenomId:   msg.DenomId,
+			Amount:    amount.String(),
+			Bidder:    msg.Bidder,
+			ExpiresAt: expiresAt,
+		},
+	)
+
+	return &types.MsgMakeOfferResponse{}, nil
+}
+
+func (m msgServer) CancelOffer(goCtx context.Context, msg *types.MsgCancelOffer) (*types.MsgCancelOfferResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelOffer(ctx, msg.Id, msg.DenomId, bidder); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelOffer{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Bidder:  msg.Bidder,
+		},
+	)
+
+	return &types.MsgCancelOfferResponse{}, nil
+}
+
+func (m msgServer) AcceptOffer(goCtx context.Context, msg *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, err := m.Keeper.AcceptOffer(ctx, msg.Id, msg.DenomId, bidder, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventAcceptOffer{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Amount:  offer.Amount,
+			Bidder:  msg.Bidder,
+			Owner:   msg.Owner,
+		},
+	)
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
+
+func (m msgServer) MakeCollectionOffer(goCtx context.Context, msg *types.MsgMakeCollectionOffer) (*types.MsgMakeCollectionOfferResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := sdk.ParseCoinNormalized(msg.Price)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOffer, "invalid offer price %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, err := m.Keeper.MakeCollectionOffer(ctx, msg.DenomId, price, msg.Quantity, msg.ExpiresAt, bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventMakeCollectionOffer{
+			OfferId:  offer.Id,
+			DenomId:  offer.DenomId,
+			Price:    offer.Price,
+			Quantity: offer.Quantity,
+			Bidder:   msg.Bidder,
+		},
+	)
+
+	return &types.MsgMakeCollectionOfferResponse{OfferId: offer.Id}, nil
+}
+
+func (m msgServer) CancelCollectionOffer(goCtx context.Context, msg *types.MsgCancelCollectionOffer) (*types.MsgCancelCollectionOfferResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, err := m.Keeper.CancelCollectionOffer(ctx, msg.OfferId, bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelCollectionOffer{
+			OfferId: offer.Id,
+			DenomId: offer.DenomId,
+			Bidder:  msg.Bidder,
+		},
+	)
+
+	return &types.MsgCancelCollectionOfferResponse{}, nil
+}
+
+func (m msgServer) AcceptCollectionOffer(goCtx context.Context, msg *types.MsgAcceptCollectionOffer) (*types.MsgAcceptCollectionOfferResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, err := m.Keeper.AcceptCollectionOffer(ctx, msg.OfferId, msg.Id, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventAcceptCollectionOffer{
+			OfferId: offer.Id,
+			DenomId: offer.DenomId,
+			Id:      msg.Id,
+			Price:   offer.Price,
+			Bidder:  offer.Bidder,
+			Owner:   msg.Owner,
+		},
+	)
+
+	return &types.MsgAcceptCollectionOfferResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (m msgServer) SetMintPhases(goCtx context.Context, msg *types.MsgSetMintPhases) (*types.MsgSetMintPhasesResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetMintPhases(ctx, msg.DenomId, msg.Phases, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSetMintPhases{
+			DenomId: msg.DenomId,
+			Phases:  uint64(len(msg.Phases)),
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgSetMintPhasesResponse{}, nil
+}
+
+func (m msgServer) SetAllowlist(goCtx context.Context, msg *types.MsgSetAllowlist) (*types.MsgSetAllowlistResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]sdk.AccAddress, 0, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetAllowlist(ctx, msg.DenomId, msg.PhaseId, addresses, msg.MerkleRoot, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSetAllowlist{
+			DenomId:   msg.DenomId,
+			PhaseId:   msg.PhaseId,
+			Addresses: uint64(len(msg.Addresses)),
+			Sender:    msg.Sender,
+		},
+	)
+
+	return &types.MsgSetAllowlistResponse{}, nil
+}
+
+func (m msgServer) RedeemVoucher(goCtx context.Context, msg *types.MsgRedeemVoucher) (*types.MsgRedeemVoucherResponse, error) {
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	price, err := m.Keeper.RedeemVoucher(ctx, msg.Voucher, msg.Signature, redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := m.GetDenom(ctx, msg.Voucher.DenomId)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &types.EventRedeemVoucher{
+		DenomId:  msg.Voucher.DenomId,
+		Id:       msg.Voucher.NftId,
+		Creator:  denom.Creator,
+		Redeemer: msg.Redeemer,
+		Nonce:    msg.Voucher.Nonce,
+	}
+	if len(msg.Voucher.Price) > 0 {
+		event.Price = price.String()
+	}
+	ctx.EventManager().EmitTypedEvent(event)
+
+	return &types.MsgRedeemVoucherResponse{}, nil
+}
+
+func (m msgServer) GrantMinter(goCtx context.Context, msg *types.MsgGrantMinter) (*types.MsgGrantMinterResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.GrantMinter(ctx, msg.DenomId, minter, msg.Quota, msg.ExpiresAt, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventGrantMinter{
+			DenomId: msg.DenomId,
+			Minter:  msg.Minter,
+			Quota:   msg.Quota,
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgGrantMinterResponse{}, nil
+}
+
+func (m msgServer) RevokeMinter(goCtx context.Context, msg *types.MsgRevokeMinter) (*types.MsgRevokeMinterResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevokeMinter(ctx, msg.DenomId, minter, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRevokeMinter{
+			DenomId: msg.DenomId,
+			Minter:  msg.Minter,
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgRevokeMinterResponse{}, nil
+}
+
+func (m msgServer) TransferDenomOwnership(goCtx context.Context, msg *types.MsgTransferDenomOwnership) (*types.MsgTransferDenomOwnershipResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.TransferDenomOwnership(ctx, msg.DenomId, recipient, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventProposeDenomOwnership{
+			DenomId:   msg.DenomId,
+			Sender:    msg.Sender,
+			Recipient: msg.Recipient,
+		},
+	)
+
+	return &types.MsgTransferDenomOwnershipResponse{}, nil
+}
+
+func (m msgServer) AcceptDenomOwnership(goCtx context.Context, msg *types.MsgAcceptDenomOwnership) (*types.MsgAcceptDenomOwnershipResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	transfer, err := m.Keeper.AcceptDenomOwnership(ctx, msg.DenomId, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransferDenomOwnership{
+			DenomId:   transfer.DenomId,
+			Sender:    transfer.Sender,
+			Recipient: transfer.Recipient,
+		},
+	)
+
+	return &types.MsgAcceptDenomOwnershipResponse{}, nil
+}
+
+func (m msgServer) DeleteCommunity(goCtx context.Context, msg *types.MsgDeleteCommunityRequest) (*types.MsgDeleteCommunityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", msg.Address)
+	}
+
+	archived, err := m.Keeper.DeleteCommunity(ctx, msg.CommunityId, owner, msg.Force)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventDeleteCommunity{
+			Id:       msg.CommunityId,
+			Owner:    msg.Address,
+			Archived: archived,
+		},
+	)
+
+	return &types.MsgDeleteCommunityResponse{}, nil
+}
+
+func (m msgServer) TransferCommunityOwnership(goCtx context.Context, msg *types.MsgTransferCommunityOwnership) (*types.MsgTransferCommunityOwnershipResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.TransferCommunityOwnership(ctx, msg.CommunityId, recipient, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransferCommunityOwnership{
+			Id:        msg.CommunityId,
+			Sender:    msg.Sender,
+			Recipient: msg.Recipient,
+		},
+	)
+
+	return &types.MsgTransferCommunityOwnershipResponse{}, nil
+}
+
+func (m msgServer) GrantCommunityRole(goCtx context.Context, msg *types.MsgGrantCommunityRole) (*types.MsgGrantCommunityRoleResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.GrantCommunityRole(ctx, msg.CommunityId, address, msg.Role, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventGrantCommunityRole{
+			Id:      msg.CommunityId,
+			Address: msg.Address,
+			Role:    msg.Role.String(),
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgGrantCommunityRoleResponse{}, nil
+}
+
+func (m msgServer) RevokeCommunityRole(goCtx context.Context, msg *types.MsgRevokeCommunityRole) (*types.MsgRevokeCommunityRoleResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevokeCommunityRole(ctx, msg.CommunityId, address, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRevokeCommunityRole{
+			Id:      msg.CommunityId,
+			Address: msg.Address,
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgRevokeCommunityRoleResponse{}, nil
+}
+
+func (m msgServer) SetMembershipPolicy(goCtx context.Context, msg *types.MsgSetMembershipPolicy) (*types.MsgSetMembershipPolicyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetMembershipPolicy(ctx, msg.CommunityId, msg.Policy, msg.GateDenomId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSetMembershipPolicy{
+			Id:     msg.CommunityId,
+			Policy: msg.Policy.String(),
+			Sender: msg.Sender,
+		},
+	)
+
+	return &types.MsgSetMembershipPolicyResponse{}, nil
+}
+
+func (m msgServer) CreateCommunityInvite(goCtx context.Context, msg *types.MsgCreateCommunityInvite) (*types.MsgCreateCommunityInviteResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CreateCommunityInvite(ctx, msg.CommunityId, msg.CodeHash, msg.MaxUses, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateCommunityInvite{
+			Id:      msg.CommunityId,
+			MaxUses: msg.MaxUses,
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgCreateCommunityInviteResponse{}, nil
+}
+
+func (m msgServer) ApproveJoinRequest(goCtx context.Context, msg *types.MsgApproveJoinRequest) (*types.MsgApproveJoinRequestResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ApproveJoinRequest(ctx, msg.CommunityId, address, msg.Reject, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventApproveJoinRequest{
+			Id:       msg.CommunityId,
+			Address:  msg.Address,
+			Approved: !msg.Reject,
+			Sender:   msg.Sender,
+		},
+	)
+
+	return &types.MsgApproveJoinRequestResponse{}, nil
+}
+
+func (m msgServer) LeaveCommunity(goCtx context.Context, msg *types.MsgLeaveCommunity) (*types.MsgLeaveCommunityResponse, error) {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.LeaveCommunity(ctx, msg.CommunityId, address); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventLeaveCommunity{
+			Id:      msg.CommunityId,
+			Address: msg.Address,
+		},
+	)
+
+	return &types.MsgLeaveCommunityResponse{}, nil
+}
+
+func (m msgServer) RemoveMember(goCtx context.Context, msg *types.MsgRemoveMember) (*types.MsgRemoveMemberResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RemoveMember(ctx, msg.CommunityId, address, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRemoveMember{
+			Id:      msg.CommunityId,
+			Address: msg.Address,
+			Sender:  msg.Sender,
+		},
+	)
+
+	return &types.MsgRemoveMemberResponse{}, nil
+}
+
+func (m msgServer) SetTreasuryCut(goCtx context.Context, msg *types.MsgSetTreasuryCut) (*types.MsgSetTreasuryCutResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetTreasuryCut(ctx, msg.CommunityId, msg.CutBps, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSetTreasuryCut{
+			Id:     msg.CommunityId,
+			CutBps: msg.CutBps,
+			Sender: msg.Sender,
+		},
+	)
+
+	return &types.MsgSetTreasuryCutResponse{}, nil
+}
+
+func (m msgServer) CommunitySpend(goCtx context.Context, msg *types.MsgCommunitySpend) (*types.MsgCommunitySpendResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrTreasury, "invalid spend amount %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CommunitySpend(ctx, msg.CommunityId, recipient, amount, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCommunitySpend{
+			Id:        msg.CommunityId,
+			Recipient: msg.Recipient,
+			Amount:    amount.String(),
+			Sender:    msg.Sender,
+		},
+	)
+
+	return &types.MsgCommunitySpendResponse{}, nil
+}
+
+func (m msgServer) SetCommunityGovernance(goCtx context.Context, msg *types.MsgSetCommunityGovernance) (*types.MsgSetCommunityGovernanceResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetCommunityGovernance(ctx, msg.CommunityId, msg.VotingWeight, msg.VotingPeriod, msg.QuorumBps, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSetCommunityGovernance{
+			Id:           msg.CommunityId,
+			VotingWeight: msg.VotingWeight.String(),
+			VotingPeriod: msg.VotingPeriod.String(),
+			QuorumBps:    msg.QuorumBps,
+			Sender:       msg.Sender,
+		},
+	)
+
+	return &types.MsgSetCommunityGovernanceResponse{}, nil
+}
+
+func (m msgServer) SubmitCommunityProposal(goCtx context.Context, msg *types.MsgSubmitCommunityProposal) (*types.MsgSubmitCommunityProposalResponse, error) {
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID, err := m.Keeper.SubmitCommunityProposal(ctx, types.CommunityProposal{
+		CommunityId: msg.CommunityId,
+		Title:       msg.Title,
+		Description: msg.Description,
+		Kind:        msg.Kind,
+		Address:     msg.Address,
+		Amount:      msg.Amount,
+		Metadata:    msg.Metadata,
+	}, proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSubmitCommunityProposal{
+			Id:         msg.CommunityId,
+			ProposalId: proposalID,
+			Kind:       msg.Kind.String(),
+			Proposer:   msg.Proposer,
+		},
+	)
+
+	return &types.MsgSubmitCommunityProposalResponse{ProposalId: proposalID}, nil
+}
+
+func (m msgServer) VoteCommunityProposal(goCtx context.Context, msg *types.MsgVoteCommunityProposal) (*types.MsgVoteCommunityProposalResponse, error) {
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := m.Keeper.VoteCommunityProposal(ctx, msg.ProposalId, msg.Option, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventVoteCommunityProposal{
+			Id:         proposal.CommunityId,
+			ProposalId: msg.ProposalId,
+			Option:     msg.Option.String(),
+			Voter:      msg.Voter,
+		},
+	)
+
+	return &types.MsgVoteCommunityProposalResponse{}, nil
+}
+
+func (m msgServer) CreateBadgeDenom(goCtx context.Context, msg *types.MsgCreateBadgeDenom) (*types.MsgCreateBadgeDenomResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	name := strings.ToLower(strings.TrimSpace(msg.Name))
+	if err := m.Keeper.CreateBadgeDenom(ctx, id, name, msg.Symbol, msg.Description, msg.PreviewURI, msg.CommunityId, msg.Data, creator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateDenom{
+			Id:      msg.Id,
+			Symbol:  msg.Symbol,
+			Name:    msg.Name,
+			Creator: msg.Creator,
+		},
+	)
+
+	return &types.MsgCreateBadgeDenomResponse{}, nil
+}
+
+func (m msgServer) IssueBadge(goCtx context.Context, msg *types.MsgIssueBadge) (*types.MsgIssueBadgeResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	community, err := m.Keeper.IssueBadge(ctx, msg.DenomId, msg.Id, msg.Metadata, msg.Data, recipient, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventIssueBadge{
+			Id:          msg.Id,
+			DenomId:     msg.DenomId,
+			CommunityId: community.Id,
+			Recipient:   msg.Recipient,
+			Sender:      msg.Sender,
+		},
+	)
+
+	return &types.MsgIssueBadgeResponse{}, nil
+}
+
+func (m msgServer) RevokeBadge(goCtx context.Context, msg *types.MsgRevokeBadge) (*types.MsgRevokeBadgeResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	community, holder, err := m.Keeper.RevokeBadge(ctx, msg.DenomId, msg.Id, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRevokeBadge{
+			Id:          msg.Id,
+			DenomId:     msg.DenomId,
+			CommunityId: community.Id,
+			Holder:      holder.String(),
+			Reason:      msg.Reason,
+			Sender:      msg.Sender,
+		},
+	)
+
+	return &types.MsgRevokeBadgeResponse{}, nil
+}
+
+func (m msgServer) ApproveNFT(goCtx context.Context, msg *types.MsgApproveNFT) (*types.MsgApproveNFTResponse, error) {
+	spender, err := sdk.AccAddressFromBech32(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	nft, err := m.Keeper.ApproveNFT(ctx, msg.DenomId, msg.Id, spender, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventApproveNFT{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Owner:   nft.Owner,
+			Spender: msg.Spender,
+		},
+	)
+
+	return &types.MsgApproveNFTResponse{}, nil
+}
+
+func (m msgServer) RevokeNFTApproval(goCtx context.Context, msg *types.MsgRevokeNFTApproval) (*types.MsgRevokeNFTApprovalResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	nft, approval, err := m.Keeper.RevokeNFTApproval(ctx, msg.DenomId, msg.Id, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRevokeNFTApproval{
+			Id:      msg.Id,
+			DenomId: msg.DenomId,
+			Owner:   nft.Owner,
+			Spender: approval.Spender,
+		},
+	)
+
+	return &types.MsgRevokeNFTApprovalResponse{}, nil
+}
+
+func (m msgServer) ApproveOperator(goCtx context.Context, msg *types.MsgApproveOperator) (*types.MsgApproveOperatorResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ApproveOperator(ctx, owner, operator, msg.DenomId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventApproveOperator{
+			Owner:    msg.Sender,
+			Operator: msg.Operator,
+			DenomId:  msg.DenomId,
+		},
+	)
+
+	return &types.MsgApproveOperatorResponse{}, nil
+}
+
+func (m msgServer) RevokeOperator(goCtx context.Context, msg *types.MsgRevokeOperator) (*types.MsgRevokeOperatorResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevokeOperator(ctx, owner, operator, msg.DenomId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRevokeOperator{
+			Owner:    msg.Sender,
+			Operator: msg.Operator,
+			DenomId:  msg.DenomId,
+		},
+	)
+
+	return &types.MsgRevokeOperatorResponse{}, nil
 }
@@ -83,4 +83,5 @@ func (k Keeper) SetNFT(ctx sdk.Context, denomID string, nft types.NFT) {
 func (k Keeper) deleteNFT(ctx sdk.Context, denomID string, nft exported.NFT) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyNFT(denomID, nft.GetID()))
+	k.deleteNFTApproval(ctx, denomID, nft.GetID())
 }
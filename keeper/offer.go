@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// MakeOffer escrows the offered amount for an nft. A bidder holds at most one offer per nft.
+func (k Keeper) MakeOffer(ctx sdk.Context, id, denomID string, amount sdk.Coin, expiresAt *time.Time, bidder sdk.AccAddress) (types.Offer, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomId %s does not exist", denomID)
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, id)
+	if err != nil {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidNFT, "nft %s does not exist in collection %s", id, denomID)
+	}
+
+	if bidder.Equals(nft.GetOwner()) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "owner cannot make an offer on own nft")
+	}
+
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "offer expiry %s is in the past", expiresAt)
+	}
+
+	if err := k.validatePaymentDenom(ctx, amount.Denom); err != nil {
+		return types.Offer{}, err
+	}
+
+	if k.HasOffer(ctx, denomID, id, bidder) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "%s already has an offer on nft %s", bidder, id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.Coins{amount}); err != nil {
+		return types.Offer{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to escrow offer %s", err.Error())
+	}
+
+	offer := types.NewOffer(id, denomID, amount.String(), expiresAt, bidder)
+	k.SetOffer(ctx, offer)
+	return offer, nil
+}
+
+// CancelOffer withdraws an offer and refunds the bidder
+func (k Keeper) CancelOffer(ctx sdk.Context, id, denomID string, bidder sdk.AccAddress) error {
+	offer, err := k.GetOffer(ctx, denomID, id, bidder)
+	if err != nil {
+		return err
+	}
+
+	if err := k.refundOffer(ctx, offer); err != nil {
+		return err
+	}
+
+	k.deleteOffer(ctx, offer)
+	return nil
+}
+
+// AcceptOffer sells an unlisted nft to the bidder, paying the creator royalty and the owner
+// from the escrowed offer. The sender is the owner, its approved spender or an operator of the owner.
+func (k Keeper) AcceptOffer(ctx sdk.Context, id, denomID string, bidder, sender sdk.AccAddress) (types.Offer, error) {
+	offer, err := k.GetOffer(ctx, denomID, id, bidder)
+	if err != nil {
+		return types.Offer{}, err
+	}
+
+	if offer.IsExpired(ctx.BlockTime()) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "offer of %s on nft %s has expired", bidder, id)
+	}
+
+	nft, err := k.AuthorizeSpender(ctx, denomID, id, sender)
+	if err != nil {
+		return types.Offer{}, err
+	}
+	owner := nft.GetOwner()
+
+	if !nft.IsTransferable() {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrTransfer, "nft %s is not transferable", id)
+	}
+
+	if nft.Listed {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrListedNFT, "nft %s is listed in market place", id)
+	}
+
+	price, err := sdk.ParseDecCoin(offer.Amount)
+	if err != nil {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "unable to parse the offer amount %s", err.Error())
+	}
+
+	if err := k.distributeSale(ctx, k.GetEscrowAddress(), denomID, nft, owner, price); err != nil {
+		return types.Offer{}, err
+	}
+
+	nft.Owner = bidder.String()
+	k.SetNFT(ctx, denomID, nft)
+	k.swapOwner(ctx, denomID, id, owner, bidder)
+
+	k.deleteOffer(ctx, offer)
+	return offer, nil
+}
+
+// ExpireOffer refunds and removes an offer whose expiry has passed
+func (k Keeper) ExpireOffer(ctx sdk.Context, offer types.Offer) error {
+	if err := k.refundOffer(ctx, offer); err != nil {
+		return err
+	}
+
+	k.deleteOffer(ctx, offer)
+	return nil
+}
+
+// refundOffers refunds and removes every offer made on an nft
+func (k Keeper) refundOffers(ctx sdk.Context, denomID, id string) error {
+	for _, offer := range k.GetOffersByNFT(ctx, denomID, id) {
+		if err := k.refundOffer(ctx, offer); err != nil {
+			return err
+		}
+		k.deleteOffer(ctx, offer)
+	}
+	return nil
+}
+
+func (k Keeper) refundOffer(ctx sdk.Context, offer types.Offer) error {
+	amount, err := sdk.ParseCoinNormalized(offer.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidOffer, "unable to parse the offer amount %s", err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, offer.GetBidder(), sdk.Coins{amount}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to refund offer %s", err.Error())
+	}
+	return nil
+}
+
+// SetOffer stores an offer along with its bidder index and expiry queue entry
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.KeyOffer(offer.DenomId, offer.NftId, offer.Bidder), bz)
+	store.Set(types.KeyOfferBidder(offer.GetBidder(), offer.DenomId, offer.NftId), []byte{})
+	if offer.ExpiresAt != nil {
+		store.Set(types.KeyOfferQueue(*offer.ExpiresAt, offer.DenomId, offer.NftId, offer.Bidder), []byte{})
+	}
+}
+
+func (k Keeper) HasOffer(ctx sdk.Context, denomID, id string, bidder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyOffer(denomID, id, bidder.String()))
+}
+
+func (k Keeper) GetOffer(ctx sdk.Context, denomID, id string, bidder sdk.AccAddress) (types.Offer, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyOffer(denomID, id, bidder.String()))
+	if bz == nil {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrUnknownOffer, "%s has no offer on nft %s", bidder, id)
+	}
+
+	var offer types.Offer
+	k.cdc.MustUnmarshal(bz, &offer)
+	return offer, nil
+}
+
+// GetOffersByNFT returns all the offers made on an nft
+func (k Keeper) GetOffersByNFT(ctx sdk.Context, denomID, id string) (offers []types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyOffer(denomID, id, ""))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// GetOffers returns all the open offers
+func (k Keeper) GetOffers(ctx sdk.Context) (offers []types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixOffer)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+func (k Keeper) deleteOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOffer(offer.DenomId, offer.NftId, offer.Bidder))
+	store.Delete(types.KeyOfferBidder(offer.GetBidder(), offer.DenomId, offer.NftId))
+	if offer.ExpiresAt != nil {
+		store.Delete(types.KeyOfferQueue(*offer.ExpiresAt, offer.DenomId, offer.NftId, offer.Bidder))
+	}
+}
+
+// DequeueOffer removes an offer from the expiry queue, the offer is kept and can be cancelled by its bidder
+func (k Keeper) DequeueOffer(ctx sdk.Context, offer types.Offer) {
+	if offer.ExpiresAt == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOfferQueue(*offer.ExpiresAt, offer.DenomId, offer.NftId, offer.Bidder))
+}
+
+// IterateExpiredOffers iterates over the offers that expired at or before the given time
+func (k Keeper) IterateExpiredOffers(ctx sdk.Context, expiresAt time.Time, cb func(offer types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PrefixOfferQueue, sdk.PrefixEndBytes(types.KeyOfferQueue(expiresAt, "", "", "")))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, denomID, tokenID, bidder, err := types.SplitKeyOfferQueue(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+		if err != nil {
+			panic(err)
+		}
+
+		offer, err := k.GetOffer(ctx, denomID, tokenID, bidderAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(offer) {
+			break
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestCancelOffer() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	_, err := suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, address3)
+	suite.Require().NoError(err)
+	suite.True(suite.balance(address3).IsZero())
+	suite.Equal(sdk.NewInt(100), suite.balance(suite.keeper.GetEscrowAddress()))
+
+	suite.Require().NoError(suite.keeper.CancelOffer(suite.ctx, tokenID, denomID, address3))
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+	suite.False(suite.keeper.HasOffer(suite.ctx, denomID, tokenID, address3))
+}
+
+func (suite *KeeperSuite) TestExpireOffer() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	expiresAt := blockTime.Add(time.Hour)
+	_, err := suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), &expiresAt, address3)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(expiresAt)
+	_, err = suite.keeper.AcceptOffer(suite.ctx, tokenID, denomID, address3, address2)
+	suite.Require().ErrorIs(err, types.ErrInvalidOffer)
+
+	var expired []types.Offer
+	suite.keeper.IterateExpiredOffers(suite.ctx, suite.ctx.BlockTime(), func(offer types.Offer) bool {
+		expired = append(expired, offer)
+		return false
+	})
+	suite.Require().Len(expired, 1)
+	suite.Require().NoError(suite.keeper.ExpireOffer(suite.ctx, expired[0]))
+
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+	suite.Empty(suite.keeper.GetOffers(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestBurnNFTRefundsOffers() {
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	suite.fund(address4, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	_, err := suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), nil, address3)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 70), nil, address4)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address2))
+
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+	suite.Equal(sdk.NewInt(100), suite.balance(address4))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+	suite.Empty(suite.keeper.GetOffersByNFT(suite.ctx, denomID, tokenID))
+}
+
+func (suite *KeeperSuite) TestAcceptOffer() {
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	_, err := suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, address3)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), nil, address2)
+	suite.Require().ErrorIs(err, types.ErrInvalidOffer)
+
+	_, err = suite.keeper.AcceptOffer(suite.ctx, tokenID, denomID, address3, address2)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt(10), suite.balance(address))
+	suite.Equal(sdk.NewInt(90), suite.balance(address2))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address3))
+	suite.Zero(suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+	suite.False(suite.keeper.HasOffer(suite.ctx, denomID, tokenID, address3))
+}
+
+func (suite *KeeperSuite) TestAcceptOfferByOperator() {
+	suite.setFeeBps(100)
+	suite.mintNFT(denomID, tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	_, err := suite.keeper.MakeOffer(suite.ctx, tokenID, denomID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, address3)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.AcceptOffer(suite.ctx, tokenID, denomID, address3, address4)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.ApproveOperator(suite.ctx, address2, address4, denomID))
+	_, err = suite.keeper.AcceptOffer(suite.ctx, tokenID, denomID, address3, address4)
+	suite.Require().NoError(err)
+
+	// the owner is paid, not the operator
+	suite.Equal(sdk.NewInt(1), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(10), suite.balance(address))
+	suite.Equal(sdk.NewInt(89), suite.balance(address2))
+	suite.True(suite.balance(address4).IsZero())
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+	suite.Equal([]string{tokenID}, suite.keeper.GetOwner(suite.ctx, address3, denomID).IDCollections[0].NftIds)
+}
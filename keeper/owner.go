@@ -87,6 +87,9 @@ func (k Keeper) swapOwner(ctx sdk.Context,
 	
 	// set new owner key
 	k.setOwner(ctx, denomID, tokenID, dstOwner)
+
+	// the spender of the old owner is not allowed to move the nft anymore
+	k.deleteNFTApproval(ctx, denomID, tokenID)
 }
 
 func (k Keeper) GetOwnerNFTs(ctx sdk.Context, owner sdk.AccAddress) []types.OwnerNFTCollection {
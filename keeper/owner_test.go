@@ -1,35 +1,20 @@
 package keeper_test
 
-import (
-	"github.com/AutonomyNetwork/nft/keeper"
-)
-
 func (suite *KeeperSuite) TestGetOwners() {
 	
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
-	suite.NoError(err)
-	
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address2)
-	suite.NoError(err)
-	
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenData, address3)
-	suite.NoError(err)
+	suite.mintNFT(denomID, tokenID, "0", address, address)
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+	suite.mintNFT(denomID, tokenID3, "0", address3, address)
 	
 	owners := suite.keeper.GetOwners(suite.ctx)
 	suite.Equal(3, len(owners))
 	
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenData, address)
-	suite.NoError(err)
-	
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenData, address2)
-	suite.NoError(err)
-	
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenData, address3)
-	suite.NoError(err)
+	suite.mintNFT(denomID2, tokenID, "0", address, address)
+	suite.mintNFT(denomID2, tokenID2, "0", address2, address)
+	suite.mintNFT(denomID2, tokenID3, "0", address3, address)
 	
 	owners = suite.keeper.GetOwners(suite.ctx)
 	suite.Equal(3, len(owners))
 	
-	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
-	suite.False(fail, msg)
+	suite.checkSupply()
 }
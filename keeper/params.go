@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// GetParams returns the total set of nft module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the nft module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// FilledOrderRetention returns how long filled orders are kept before they are pruned
+func (k Keeper) FilledOrderRetention(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyFilledOrderRetention, &res)
+	return
+}
+
+// MarketplaceFeeBps returns the protocol fee charged on sales, in basis points
+func (k Keeper) MarketplaceFeeBps(ctx sdk.Context) (res uint32) {
+	k.paramSpace.Get(ctx, types.KeyMarketplaceFeeBps, &res)
+	return
+}
+
+// chargeDenomCreationFee sends the denom creation fee from the creator to the fee pool
+func (k Keeper) chargeDenomCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	fee := k.GetParams(ctx).DenomCreationFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.FeePoolName, fee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to pay the denom creation fee %s", err.Error())
+	}
+
+	collected := k.GetCollectedFees(ctx, "")
+	collected.Amount = collected.Amount.Add(fee...)
+	k.SetCollectedFees(ctx, collected)
+	return nil
+}
+
+func (k Keeper) validateRoyalty(ctx sdk.Context, royalty sdk.Dec) error {
+	maxRoyalty := k.GetParams(ctx).MaxRoyalty
+	if royalty.IsNegative() || royalty.GT(maxRoyalty) {
+		return sdkerrors.Wrapf(types.ErrInvalidNFT, "royalities in between 0 to %s; given :%s", maxRoyalty, royalty)
+	}
+	return nil
+}
+
+func (k Keeper) validatePaymentDenom(ctx sdk.Context, denom string) error {
+	if !k.GetParams(ctx).IsAllowedPaymentDenom(denom) {
+		return sdkerrors.Wrapf(types.ErrInvalidPayment, "%s is not an allowed payment denom", denom)
+	}
+	return nil
+}
+
+func (k Keeper) validateListingDuration(ctx sdk.Context, duration time.Duration) error {
+	params := k.GetParams(ctx)
+	if duration < params.MinListingDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidDuration, "duration %s is shorter than %s", duration, params.MinListingDuration)
+	}
+
+	if params.MaxListingDuration > 0 && duration > params.MaxListingDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidDuration, "duration %s is longer than %s", duration, params.MaxListingDuration)
+	}
+	return nil
+}
+
+// listingExpiry checks the expiry of a new listing against the listing durations. Listings without
+// an expiry expire after the max listing duration when it is set.
+func (k Keeper) listingExpiry(ctx sdk.Context, expiresAt *time.Time) (*time.Time, error) {
+	if expiresAt == nil {
+		maxDuration := k.GetParams(ctx).MaxListingDuration
+		if maxDuration == 0 {
+			return nil, nil
+		}
+
+		expiry := ctx.BlockTime().Add(maxDuration)
+		return &expiry, nil
+	}
+
+	if err := k.validateListingDuration(ctx, expiresAt.Sub(ctx.BlockTime())); err != nil {
+		return nil, err
+	}
+	return expiresAt, nil
+}
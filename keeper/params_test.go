@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MarketplaceFeeBps = 250
+	params.FilledOrderRetention = 24 * time.Hour
+
+	// only the gov module account can update the parameters
+	msg := &types.MsgUpdateParams{Authority: address.String(), Params: params}
+	_, err := suite.msgRouter.Handler(msg)(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Zero(suite.keeper.MarketplaceFeeBps(suite.ctx))
+
+	msg = &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Params: params}
+	_, err = suite.msgRouter.Handler(msg)(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Equal(uint32(250), suite.keeper.MarketplaceFeeBps(suite.ctx))
+	suite.Equal(24*time.Hour, suite.keeper.FilledOrderRetention(suite.ctx))
+
+	params.MaxRoyalty = sdk.NewDec(2)
+	msg = &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Params: params}
+	_, err = suite.msgRouter.Handler(msg)(suite.ctx, msg)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// validatePaymentInfo checks the price of a primary sale denom can be paid
+func (k Keeper) validatePaymentInfo(ctx sdk.Context, paymentInfo types.PaymentInfo) error {
+	price, err := paymentInfo.GetPrice()
+	if err != nil {
+		return err
+	}
+
+	if paymentInfo.IsFree() {
+		return nil
+	}
+	return k.validatePaymentDenom(ctx, price.Denom)
+}
+
+// MintPrimarySale mints an nft of a primary sale denom to the minter in exchange for the price of the denom,
+// or of its active mint phase when the phase sets its own price. The price is escrowed before minting and refunded
+// if the mint fails. The denom creator receives the price minus the marketplace fee and the community treasury cut.
+// The denom creator stays the creator of the nft, which inherits the royalty shares of the denom.
+func (k Keeper) MintPrimarySale(ctx sdk.Context, denom types.Denom, nftID string, transferable bool,
+	minter sdk.AccAddress, metadata types.Metadata, attributes string, merkleProof [][]byte) (types.EventPrimarySale, error) {
+	if denom.AvailableNfts <= 0 {
+		return types.EventPrimarySale{}, sdkerrors.Wrapf(types.ErrUnauthorized, "not enough nfts in %s collection to mint", denom.Id)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(denom.Creator)
+	if err != nil {
+		return types.EventPrimarySale{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid denom creator %s", err.Error())
+	}
+
+	price, err := denom.PaymentInfo.GetPrice()
+	if err != nil {
+		return types.EventPrimarySale{}, err
+	}
+
+	var phase *types.MintPhase
+	if phases := k.GetMintPhases(ctx, denom.Id); len(phases) > 0 {
+		active, err := k.checkMintPhase(ctx, denom.Id, phases, minter, merkleProof)
+		if err != nil {
+			return types.EventPrimarySale{}, err
+		}
+		phase = &active
+
+		if active.HasPrice() {
+			price, err = active.GetPrice()
+			if err != nil {
+				return types.EventPrimarySale{}, err
+			}
+		}
+	}
+
+	paid := price.IsValid() && price.IsPositive()
+	if paid {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, minter, types.ModuleName, sdk.Coins{price}); err != nil {
+			return types.EventPrimarySale{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to pay the primary sale price %s", err.Error())
+		}
+	}
+
+	if err := k.MintNFT(ctx, denom.Id, nftID, sdk.ZeroDec().String(), transferable, minter, creator, metadata, attributes, denom.RoyaltyShares); err != nil {
+		if paid {
+			if refundErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, minter, sdk.Coins{price}); refundErr != nil {
+				return types.EventPrimarySale{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to refund the primary sale price %s", refundErr.Error())
+			}
+		}
+		return types.EventPrimarySale{}, err
+	}
+
+	if phase != nil {
+		k.recordPhaseMint(ctx, *phase, minter)
+	}
+
+	sale := types.EventPrimarySale{
+		Id:        nftID,
+		DenomId:   denom.Id,
+		Minter:    minter.String(),
+		Recipient: denom.Creator,
+	}
+	if !paid {
+		return sale, nil
+	}
+	sale.Price = price.String()
+
+	fee, err := k.payMarketplaceFee(ctx, k.GetEscrowAddress(), denom.Id, nftID, sdk.NewDecCoinFromCoin(price))
+	if err != nil {
+		return types.EventPrimarySale{}, err
+	}
+	sale.Fee = fee.String()
+
+	cut, err := k.payTreasuryCut(ctx, k.GetEscrowAddress(), denom.Id, nftID, price.Sub(fee), types.TreasurySourcePrimarySale)
+	if err != nil {
+		return types.EventPrimarySale{}, err
+	}
+
+	proceeds := price.Sub(fee).Sub(cut)
+	if proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.Coins{proceeds}); err != nil {
+			return types.EventPrimarySale{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer tokens  %s", err.Error())
+		}
+	}
+	return sale, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestMintPrimarySale() {
+	suite.setFeeBps(1000)
+	suite.createPrimarySale("saledenom", 2, 100)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)))
+
+	_, err := suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: tokenID, DenomId: "saledenom", Transferable: true, Creator: address3.String()})
+	suite.Require().NoError(err)
+
+	// the minter pays the price, the creator gets it minus the protocol fee
+	suite.Equal(sdk.NewInt(50), suite.balance(address3))
+	suite.Equal(sdk.NewInt(90), suite.balance(address))
+	suite.Equal(sdk.NewInt(10), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, "saledenom")
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), denom.AvailableNfts)
+
+	// a minter that cannot pay gets nothing and keeps its funds
+	_, err = suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: tokenID2, DenomId: "saledenom", Transferable: true, Creator: address3.String()})
+	suite.Require().Error(err)
+	suite.Equal(sdk.NewInt(50), suite.balance(address3))
+	suite.False(suite.keeper.HasNFT(suite.ctx, "saledenom", tokenID2))
+}
+
+func (suite *KeeperSuite) TestMintPrimarySaleRefund() {
+	denom := suite.createPrimarySale("saledenom", 2, 100)
+	suite.mintNFT("saledenom", tokenID, "0", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	// the mint fails on the existing id and the escrowed price is refunded
+	_, err := suite.keeper.MintPrimarySale(suite.ctx, denom, tokenID, true, address3, types.Metadata{Name: tokenNm}, tokenData, nil)
+	suite.Require().Error(err)
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+	suite.True(suite.balance(suite.keeper.GetEscrowAddress()).IsZero())
+}
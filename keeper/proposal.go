@@ -0,0 +1,430 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// SetCommunityGovernance sets how the proposals of the community are voted on
+func (k Keeper) SetCommunityGovernance(ctx sdk.Context, id string, votingWeight types.VotingWeight, votingPeriod time.Duration, quorumBps uint32, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanManage() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to update the community: %s", sender)
+	}
+
+	community.VotingWeight = votingWeight
+	community.VotingPeriod = votingPeriod
+	community.QuorumBps = quorumBps
+	k.SetCommunity(ctx, community)
+	return nil
+}
+
+// SubmitCommunityProposal opens a proposal of the community for votes. Only addresses with voting
+// power in the community can submit proposals.
+func (k Keeper) SubmitCommunityProposal(ctx sdk.Context, proposal types.CommunityProposal, proposer sdk.AccAddress) (uint64, error) {
+	community, found := k.GetCommunityByID(ctx, proposal.CommunityId)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", proposal.CommunityId)
+	}
+
+	if community.Archived {
+		return 0, sdkerrors.Wrapf(types.ErrUnauthorized, "community %s is archived", community.Id)
+	}
+
+	if k.GetVotingPower(ctx, community, proposer) == 0 {
+		return 0, sdkerrors.Wrapf(types.ErrUnauthorized, "%s has no voting power in community %s", proposer, community.Id)
+	}
+
+	if err := types.ValidateProposalContent(proposal.Kind, proposal.Address, proposal.Amount); err != nil {
+		return 0, err
+	}
+
+	proposal.Id = k.nextProposalID(ctx)
+	proposal.Proposer = proposer.String()
+	proposal.Status = types.StatusVoting
+	proposal.SubmitTime = ctx.BlockTime()
+	proposal.VotingEndTime = ctx.BlockTime().Add(community.GetVotingPeriod())
+	proposal.FinalTally = types.TallyResult{}
+
+	k.SetProposal(ctx, proposal)
+	k.InsertProposalQueue(ctx, proposal)
+	return proposal.Id, nil
+}
+
+// VoteCommunityProposal records the vote of the voter on a proposal open for votes, replacing its previous vote.
+// The voting power of the voter is fixed when the vote is cast, except that every nft votes once per proposal.
+func (k Keeper) VoteCommunityProposal(ctx sdk.Context, proposalID uint64, option types.VoteOption, voter sdk.AccAddress) (types.CommunityProposal, error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return proposal, sdkerrors.Wrapf(types.ErrUnknownProposal, "proposal %d not exists", proposalID)
+	}
+
+	if proposal.Status != types.StatusVoting || !ctx.BlockTime().Before(proposal.VotingEndTime) {
+		return proposal, sdkerrors.Wrapf(types.ErrInvalidProposal, "proposal %d is not open for votes", proposalID)
+	}
+
+	community, found := k.GetCommunityByID(ctx, proposal.CommunityId)
+	if !found {
+		return proposal, sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", proposal.CommunityId)
+	}
+
+	vote := types.CommunityVote{ProposalId: proposalID, Voter: voter.String(), Option: option}
+	if community.VotingWeight == types.WeightNFT {
+		vote.NFTs = k.getVotingNFTs(ctx, community, proposalID, voter)
+		vote.Power = k.countHeldNFTs(ctx, voter, vote.NFTs)
+	} else {
+		vote.Power = k.GetVotingPower(ctx, community, voter)
+	}
+
+	if vote.Power == 0 {
+		return proposal, sdkerrors.Wrapf(types.ErrUnauthorized, "%s has no voting power in community %s", voter, community.Id)
+	}
+
+	k.SetVote(ctx, vote)
+	return proposal, nil
+}
+
+// getVotingNFTs returns the nfts the voter votes with on a proposal: the nfts of its previous vote and the nfts of
+// the community's denoms it holds that did not vote on the proposal yet. An nft transferred after voting cannot
+// vote again.
+func (k Keeper) getVotingNFTs(ctx sdk.Context, community types.Community, proposalID uint64, voter sdk.AccAddress) []types.NFTRef {
+	store := ctx.KVStore(k.storeKey)
+
+	var nfts []types.NFTRef
+	if vote, found := k.getVote(ctx, proposalID, voter); found {
+		nfts = vote.NFTs
+	}
+
+	for _, denom := range k.getCommunityDenoms(ctx, community.Id) {
+		for _, collection := range k.GetOwner(ctx, voter, denom.Id).IDCollections {
+			for _, tokenID := range collection.NftIds {
+				if store.Has(types.KeyProposalNFTVote(proposalID, denom.Id, tokenID)) {
+					continue
+				}
+				nfts = append(nfts, types.NFTRef{DenomId: denom.Id, NftId: tokenID})
+			}
+		}
+	}
+	return nfts
+}
+
+// countHeldNFTs returns how many of the nfts the address still holds
+func (k Keeper) countHeldNFTs(ctx sdk.Context, address sdk.AccAddress, nfts []types.NFTRef) (count uint64) {
+	for _, ref := range nfts {
+		nft, err := k.GetNFT(ctx, ref.DenomId, ref.NftId)
+		if err != nil {
+			continue
+		}
+		if nft.GetOwner().Equals(address) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetVotingPower returns the voting power of the address in the community: one vote for every member
+// including the owner, or one vote for every nft held of the community's denoms
+func (k Keeper) GetVotingPower(ctx sdk.Context, community types.Community, address sdk.AccAddress) uint64 {
+	if community.VotingWeight == types.WeightNFT {
+		var power uint64
+		for _, denom := range k.getCommunityDenoms(ctx, community.Id) {
+			power += k.GetTotalSupplyOfOwner(ctx, denom.Id, address)
+		}
+		return power
+	}
+
+	if k.GetCommunityRole(ctx, community, address) == types.RoleNone {
+		return 0
+	}
+	return 1
+}
+
+// getTotalVotingPower returns the voting power of the whole community. The nfts held by the module
+// escrow and the fee pool cannot vote and are left out.
+func (k Keeper) getTotalVotingPower(ctx sdk.Context, community types.Community) uint64 {
+	if community.VotingWeight == types.WeightNFT {
+		var power uint64
+		for _, denom := range k.getCommunityDenoms(ctx, community.Id) {
+			power += k.GetTotalSupply(ctx, denom.Id)
+			power -= k.GetTotalSupplyOfOwner(ctx, denom.Id, k.GetEscrowAddress())
+			power -= k.GetTotalSupplyOfOwner(ctx, denom.Id, k.GetFeePoolAddress())
+		}
+		return power
+	}
+
+	// the owner is not stored as a member
+	return uint64(len(k.iterateCommunityMembers(ctx, types.KeyCommunityMember(community.Id, nil)))) + 1
+}
+
+// getCommunityDenoms returns the denoms of a community from the community denom index
+func (k Keeper) getCommunityDenoms(ctx sdk.Context, id string) (denoms []types.Denom) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.KeyCommunityDenom(id, "")
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom, err := k.GetDenom(ctx, string(iterator.Key()[len(prefix):]))
+		if err != nil {
+			continue
+		}
+		denoms = append(denoms, denom)
+	}
+	return denoms
+}
+
+// TallyCommunityProposal counts the votes on a proposal with the voting power each voter had when voting. In
+// communities weighting votes by nfts held, only the nfts a voter still holds count.
+func (k Keeper) TallyCommunityProposal(ctx sdk.Context, community types.Community, proposal types.CommunityProposal) types.TallyResult {
+	tally := types.TallyResult{TotalPower: k.getTotalVotingPower(ctx, community)}
+	for _, vote := range k.GetVotes(ctx, proposal.Id) {
+		power := vote.Power
+		if community.VotingWeight == types.WeightNFT {
+			power = k.countHeldNFTs(ctx, sdk.MustAccAddressFromBech32(vote.Voter), vote.NFTs)
+		}
+
+		switch vote.Option {
+		case types.VoteYes:
+			tally.Yes += power
+		case types.VoteNo:
+			tally.No += power
+		case types.VoteAbstain:
+			tally.Abstain += power
+		}
+	}
+	return tally
+}
+
+// EndCommunityProposal tallies a proposal whose voting period ended and executes it if it passed. A proposal
+// that fails to execute changes nothing and is marked as failed.
+func (k Keeper) EndCommunityProposal(ctx sdk.Context, proposal types.CommunityProposal) types.CommunityProposal {
+	k.removeProposalQueue(ctx, proposal)
+
+	community, found := k.GetCommunityByID(ctx, proposal.CommunityId)
+	if !found {
+		proposal.Status = types.StatusRejected
+	} else {
+		proposal.FinalTally = k.TallyCommunityProposal(ctx, community, proposal)
+		proposal.Status = types.StatusRejected
+		if proposal.FinalTally.Passes(community.QuorumBps) {
+			proposal.Status = types.StatusPassed
+
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.executeCommunityProposal(cacheCtx, proposal); err != nil {
+				proposal.Status = types.StatusFailed
+			} else {
+				write()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			}
+		}
+	}
+
+	k.deleteVotes(ctx, proposal.Id)
+	k.SetProposal(ctx, proposal)
+	return proposal
+}
+
+func (k Keeper) executeCommunityProposal(ctx sdk.Context, proposal types.CommunityProposal) error {
+	community, found := k.GetCommunityByID(ctx, proposal.CommunityId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", proposal.CommunityId)
+	}
+
+	switch proposal.Kind {
+	case types.ProposalTreasurySpend:
+		recipient, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			return err
+		}
+
+		amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidProposal, "invalid spend amount %s", err)
+		}
+		return k.spendTreasury(ctx, community.Id, recipient, amount)
+
+	case types.ProposalUpdateMetadata:
+		community.Description = proposal.Metadata.Description
+		community.Data = proposal.Metadata.Data
+		community.Tags = proposal.Metadata.Tags
+		k.SetCommunity(ctx, community)
+		return nil
+
+	case types.ProposalApproveCreator:
+		creator, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			return err
+		}
+
+		// members that already have a higher role keep it
+		if k.GetCommunityRole(ctx, community, creator) < types.RoleCreator {
+			k.SetCommunityMember(ctx, types.CommunityMember{CommunityId: community.Id, Address: creator.String(), Role: types.RoleCreator})
+		}
+		return nil
+	}
+	return nil
+}
+
+func (k Keeper) nextProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := uint64(1)
+	if bz := store.Get(types.KeyNextProposalID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.KeyNextProposalID, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// SetNextProposalID sets the id assigned to the next community proposal
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextProposalID, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.CommunityProposal) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&proposal)
+	store.Set(types.KeyProposal(proposal.Id), bz)
+	store.Set(types.KeyCommunityProposal(proposal.CommunityId, proposal.Id), []byte{})
+}
+
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (types.CommunityProposal, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyProposal(id))
+	if bz == nil {
+		return types.CommunityProposal{}, false
+	}
+
+	var proposal types.CommunityProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// GetProposals returns the proposals of every community
+func (k Keeper) GetProposals(ctx sdk.Context) (proposals []types.CommunityProposal) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixProposal)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.CommunityProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// InsertProposalQueue schedules the proposal to be tallied at its voting end time
+func (k Keeper) InsertProposalQueue(ctx sdk.Context, proposal types.CommunityProposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyProposalQueue(proposal.VotingEndTime, proposal.Id), sdk.Uint64ToBigEndian(proposal.Id))
+}
+
+func (k Keeper) removeProposalQueue(ctx sdk.Context, proposal types.CommunityProposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyProposalQueue(proposal.VotingEndTime, proposal.Id))
+}
+
+// IterateEndedProposals iterates over the community proposals whose voting period ended at or before the given time
+func (k Keeper) IterateEndedProposals(ctx sdk.Context, endTime time.Time, cb func(proposal types.CommunityProposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PrefixProposalQueue, sdk.PrefixEndBytes(types.KeyProposalQueue(endTime, 0)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposal, found := k.GetProposal(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrUnknownProposal, "queued proposal %d not exists", sdk.BigEndianToUint64(iterator.Value())))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetVote(ctx sdk.Context, vote types.CommunityVote) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&vote)
+	store.Set(types.KeyProposalVote(vote.ProposalId, sdk.MustAccAddressFromBech32(vote.Voter)), bz)
+	for _, nft := range vote.NFTs {
+		store.Set(types.KeyProposalNFTVote(vote.ProposalId, nft.DenomId, nft.NftId), []byte{})
+	}
+}
+
+func (k Keeper) getVote(ctx sdk.Context, id uint64, voter sdk.AccAddress) (types.CommunityVote, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyProposalVote(id, voter))
+	if bz == nil {
+		return types.CommunityVote{}, false
+	}
+
+	var vote types.CommunityVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// GetVotes returns the votes on a community proposal, or on every proposal when id is zero
+func (k Keeper) GetVotes(ctx sdk.Context, id uint64) (votes []types.CommunityVote) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.PrefixProposalVote
+	if id > 0 {
+		prefix = types.KeyProposalVote(id, nil)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.CommunityVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// deleteCommunityProposals removes the proposals of a deleted community with their queue entries and votes
+func (k Keeper) deleteCommunityProposals(ctx sdk.Context, communityID string) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.KeyCommunityProposal(communityID, 0)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		if proposal, found := k.GetProposal(ctx, id); found {
+			k.removeProposalQueue(ctx, proposal)
+		}
+		k.deleteVotes(ctx, id)
+		store.Delete(types.KeyProposal(id))
+		store.Delete(types.KeyCommunityProposal(communityID, id))
+	}
+}
+
+func (k Keeper) deleteVotes(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, vote := range k.GetVotes(ctx, id) {
+		store.Delete(types.KeyProposalVote(id, sdk.MustAccAddressFromBech32(vote.Voter)))
+		for _, nft := range vote.NFTs {
+			store.Delete(types.KeyProposalNFTVote(id, nft.DenomId, nft.NftId))
+		}
+	}
+}
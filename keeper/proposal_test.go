@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft"
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// createNFTCommunity creates a community weighting votes by the nfts held of its denom, with two nfts held by
+// address2 and one by address3
+func (suite *KeeperSuite) createNFTCommunity() types.Community {
+	community := types.Community{Id: "community", Name: "community", Creator: address.String(), VotingWeight: types.WeightNFT,
+		VotingPeriod: time.Hour}
+	suite.Require().NoError(suite.keeper.SetCommunity(suite.ctx, community))
+
+	err := suite.keeper.CreateDenom(suite.ctx, "communitydenom", "communitydenom", "communitydenom", "", "", address.String(), community.Id,
+		nil, "", false, 0, 0, "", types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+	suite.mintNFT("communitydenom", tokenID, "0", address2, address)
+	suite.mintNFT("communitydenom", tokenID2, "0", address2, address)
+	suite.mintNFT("communitydenom", tokenID3, "0", address3, address)
+	return community
+}
+
+func (suite *KeeperSuite) TestVoteCommunityProposal() {
+	community := types.Community{Id: "community", Name: "community", Creator: address.String(), VotingPeriod: time.Hour, QuorumBps: 5000}
+	suite.Require().NoError(suite.keeper.SetCommunity(suite.ctx, community))
+	suite.keeper.SetCommunityMember(suite.ctx, types.CommunityMember{CommunityId: community.Id, Address: address2.String(), Role: types.RoleMember})
+
+	_, err := suite.keeper.SubmitCommunityProposal(suite.ctx, types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalText}, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	id, err := suite.keeper.SubmitCommunityProposal(suite.ctx, types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalText}, address2)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteYes, address3)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteYes, address2)
+	suite.Require().NoError(err)
+
+	proposal, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.Require().True(found)
+	proposal = suite.keeper.EndCommunityProposal(suite.ctx, proposal)
+	suite.Equal(types.StatusPassed, proposal.Status)
+	suite.Equal(types.TallyResult{Yes: 1, TotalPower: 2}, proposal.FinalTally)
+	suite.Empty(suite.keeper.GetVotes(suite.ctx, id))
+}
+
+func (suite *KeeperSuite) TestNFTVotesCountOnce() {
+	community := suite.createNFTCommunity()
+
+	id, err := suite.keeper.SubmitCommunityProposal(suite.ctx, types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalText}, address2)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteYes, address2)
+	suite.Require().NoError(err)
+
+	// the nft already voted and cannot vote again for its new holder
+	suite.Require().NoError(suite.keeper.TransferOwner(suite.ctx, "communitydenom", tokenID, address2, address4))
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteYes, address4)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the transferred nft no longer counts for its voter
+	proposal, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.TallyResult{Yes: 1, TotalPower: 3}, suite.keeper.TallyCommunityProposal(suite.ctx, community, proposal))
+
+	// voting again changes the option without recounting the transferred nft
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteNo, address2)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.VoteCommunityProposal(suite.ctx, id, types.VoteYes, address3)
+	suite.Require().NoError(err)
+	suite.Equal(types.TallyResult{Yes: 1, No: 1, TotalPower: 3}, suite.keeper.TallyCommunityProposal(suite.ctx, community, proposal))
+
+	// the nft returned to its voter counts again
+	suite.Require().NoError(suite.keeper.TransferOwner(suite.ctx, "communitydenom", tokenID, address4, address2))
+	tally := suite.keeper.TallyCommunityProposal(suite.ctx, community, proposal)
+	suite.Equal(types.TallyResult{Yes: 1, No: 2, TotalPower: 3}, tally)
+	suite.LessOrEqual(tally.Yes+tally.No+tally.Abstain, tally.TotalPower)
+
+	suite.keeper.EndCommunityProposal(suite.ctx, proposal)
+	store := suite.ctx.KVStore(suite.storeKey)
+	for _, id := range []string{tokenID, tokenID2, tokenID3} {
+		suite.False(store.Has(types.KeyProposalNFTVote(proposal.Id, "communitydenom", id)))
+	}
+}
+
+func (suite *KeeperSuite) TestTreasurySpendProposal() {
+	community := suite.createCommunity("community")
+	treasury := types.CommunityTreasuryAddress(community.Id)
+	suite.fund(treasury, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	spend := types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalTreasurySpend, Address: address3.String(), Amount: "60stake"}
+	id, err := suite.keeper.SubmitCommunityProposal(suite.ctx, spend, address2)
+	suite.Require().NoError(err)
+	overspend := types.CommunityProposal{CommunityId: community.Id, Kind: types.ProposalTreasurySpend, Address: address3.String(), Amount: "200stake"}
+	failing, err := suite.keeper.SubmitCommunityProposal(suite.ctx, overspend, address2)
+	suite.Require().NoError(err)
+
+	for _, proposalID := range []uint64{id, failing} {
+		for _, voter := range []sdk.AccAddress{address, address2} {
+			_, err = suite.keeper.VoteCommunityProposal(suite.ctx, proposalID, types.VoteYes, voter)
+			suite.Require().NoError(err)
+		}
+	}
+
+	// proposals are tallied and executed once their voting period ends
+	nft.EndBlocker(suite.ctx, suite.keeper)
+	proposal, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.StatusVoting, proposal.Status)
+
+	suite.ctx = suite.ctx.WithBlockTime(proposal.VotingEndTime)
+	nft.EndBlocker(suite.ctx, suite.keeper)
+
+	proposal, found = suite.keeper.GetProposal(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.StatusPassed, proposal.Status)
+	suite.Equal(sdk.NewInt(60), suite.balance(address3))
+	suite.Equal(sdk.NewInt(40), suite.balance(treasury))
+
+	// a passed proposal that cannot be executed changes nothing
+	proposal, found = suite.keeper.GetProposal(suite.ctx, failing)
+	suite.Require().True(found)
+	suite.Equal(types.StatusFailed, proposal.Status)
+	suite.Equal(sdk.NewInt(40), suite.balance(treasury))
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// useTokenGate checks the minter holds enough nfts of every dependent denom of the denom
+// and burns or locks them as configured by the token gate of the denom
+func (k Keeper) useTokenGate(ctx sdk.Context, denom types.Denom, minter sdk.AccAddress) error {
+	gate := denom.TokenGate
+	required := gate.GetMinHolding()
+
+	for _, dependentID := range denom.DependentDenoms {
+		if gate.Action == types.GateHold {
+			if k.GetTotalSupplyOfOwner(ctx, dependentID, minter) < required {
+				return sdkerrors.Wrapf(types.ErrTokenGate, "%s must hold %d nfts of %s to mint in %s", minter, required, dependentID, denom.Id)
+			}
+			continue
+		}
+
+		tokenIDs := k.getGateTokens(ctx, denom.Id, dependentID, minter, required)
+		if uint64(len(tokenIDs)) < required {
+			return sdkerrors.Wrapf(types.ErrTokenGate, "%s must hold %d unused nfts of %s to mint in %s", minter, required, dependentID, denom.Id)
+		}
+
+		for _, tokenID := range tokenIDs {
+			switch gate.Action {
+			case types.GateBurn:
+				if err := k.BurnNFT(ctx, dependentID, tokenID, minter); err != nil {
+					return err
+				}
+			case types.GateLock:
+				k.SetGateLock(ctx, denom.Id, dependentID, tokenID)
+			}
+		}
+	}
+	return nil
+}
+
+// getGateTokens returns up to limit nfts of the dependent denom owned by the minter which are not locked for the denom
+func (k Keeper) getGateTokens(ctx sdk.Context, denomID, dependentID string, minter sdk.AccAddress, limit uint64) (tokenIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyOwner(minter, dependentID, ""))
+	defer iterator.Close()
+	for ; iterator.Valid() && uint64(len(tokenIDs)) < limit; iterator.Next() {
+		_, _, tokenID, err := types.SplitKeyOwner(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		if k.IsGateLocked(ctx, denomID, dependentID, tokenID) {
+			continue
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	return tokenIDs
+}
+
+// SetGateLock locks the nft of the dependent denom so it cannot be used to mint in the denom again
+func (k Keeper) SetGateLock(ctx sdk.Context, denomID, dependentID, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyGateLock(denomID, dependentID, tokenID), []byte{})
+}
+
+// IsGateLocked returns true if the nft of the dependent denom was already used to mint in the denom
+func (k Keeper) IsGateLocked(ctx sdk.Context, denomID, dependentID, tokenID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyGateLock(denomID, dependentID, tokenID))
+}
+
+// GetLockedGateTokens returns every nft locked by minting in a token gated denom
+func (k Keeper) GetLockedGateTokens(ctx sdk.Context) (locks []types.LockedGateToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixGateLock)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, dependentID, tokenID, err := types.SplitKeyGateLock(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		locks = append(locks, types.LockedGateToken{DenomId: denomID, DependentDenomId: dependentID, NftId: tokenID})
+	}
+	return locks
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// createGatedDenom creates a free primary sale denom gated by holding nfts of denomID
+func (suite *KeeperSuite) createGatedDenom(id string, gate types.TokenGate) {
+	paymentInfo := types.PaymentInfo{Currency: sdk.DefaultBondDenom}
+	err := suite.keeper.CreateDenom(suite.ctx, id, id, id, "", "", address.String(), "", []string{denomID}, "", true, 10, 10, "",
+		paymentInfo, nil, gate)
+	suite.Require().NoError(err)
+}
+
+// mintGated mints an nft of the gated denom to the minter
+func (suite *KeeperSuite) mintGated(id, tokenID string, minter sdk.AccAddress) error {
+	_, err := suite.msgServer.MintNFT(sdk.WrapSDKContext(suite.ctx), &types.MsgMintNFT{Id: tokenID, DenomId: id, Transferable: true, Creator: minter.String()})
+	return err
+}
+
+func (suite *KeeperSuite) TestTokenGateHold() {
+	suite.createGatedDenom("gated", types.TokenGate{MinHolding: 2, Action: types.GateHold})
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	suite.Require().ErrorIs(suite.mintGated("gated", tokenID, address2), types.ErrTokenGate)
+
+	suite.mintNFT(denomID, tokenID2, "0", address2, address)
+	suite.Require().NoError(suite.mintGated("gated", tokenID, address2))
+	suite.Require().NoError(suite.mintGated("gated", tokenID2, address2))
+
+	// held nfts are neither burned nor locked
+	suite.Equal(uint64(2), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+	suite.Empty(suite.keeper.GetLockedGateTokens(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestTokenGateBurn() {
+	suite.createGatedDenom("gated", types.TokenGate{Action: types.GateBurn})
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	suite.Require().NoError(suite.mintGated("gated", tokenID, address2))
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+	suite.True(suite.keeper.HasNFT(suite.ctx, "gated", tokenID))
+
+	suite.Require().ErrorIs(suite.mintGated("gated", tokenID2, address2), types.ErrTokenGate)
+	suite.checkSupply()
+}
+
+func (suite *KeeperSuite) TestTokenGateLock() {
+	suite.createGatedDenom("gated", types.TokenGate{Action: types.GateLock})
+	suite.mintNFT(denomID, tokenID, "0", address2, address)
+
+	suite.Require().NoError(suite.mintGated("gated", tokenID, address2))
+	suite.True(suite.keeper.IsGateLocked(suite.ctx, "gated", denomID, tokenID))
+	suite.True(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+
+	suite.Require().ErrorIs(suite.mintGated("gated", tokenID2, address2), types.ErrTokenGate)
+
+	// the lock follows the nft to its new owner
+	suite.Require().NoError(suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, address2, address3))
+	suite.Require().ErrorIs(suite.mintGated("gated", tokenID2, address3), types.ErrTokenGate)
+}
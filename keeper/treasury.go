@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// SetTreasuryCut sets the share of primary sales and royalties of the community's denoms paid to its treasury
+func (k Keeper) SetTreasuryCut(ctx sdk.Context, id string, cutBps uint32, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanManage() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "unauthorized to update the community: %s", sender)
+	}
+
+	if cutBps > types.MaxFeeBps {
+		return sdkerrors.Wrapf(types.ErrTreasury, "treasury cut %d exceeds %d basis points", cutBps, types.MaxFeeBps)
+	}
+
+	community.TreasuryCutBps = cutBps
+	k.SetCommunity(ctx, community)
+	return nil
+}
+
+// CommunitySpend sends funds from the community treasury to the recipient. Only admins and the owner can spend.
+func (k Keeper) CommunitySpend(ctx sdk.Context, id string, recipient sdk.AccAddress, amount sdk.Coins, sender sdk.AccAddress) error {
+	community, found := k.GetCommunityByID(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommunityNotFound, "communit not exis: %s", id)
+	}
+
+	if !k.GetCommunityRole(ctx, community, sender).CanManage() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot spend from the treasury of community %s", sender, id)
+	}
+	return k.spendTreasury(ctx, id, recipient, amount)
+}
+
+func (k Keeper) spendTreasury(ctx sdk.Context, id string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoins(ctx, types.CommunityTreasuryAddress(id), recipient, amount); err != nil {
+		return sdkerrors.Wrapf(types.ErrTreasury, "unable to spend %s from the treasury of community %s: %s", amount, id, err.Error())
+	}
+
+	flow := k.GetTreasuryFlow(ctx, id)
+	flow.Spent = flow.Spent.Add(amount...)
+	k.SetTreasuryFlow(ctx, flow)
+	return nil
+}
+
+// getTreasuryCut returns the community of a denom and the share of its sales paid to the community treasury
+func (k Keeper) getTreasuryCut(ctx sdk.Context, denomID string) (string, uint32) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil || len(denom.CommunityId) == 0 {
+		return "", 0
+	}
+
+	community, found := k.GetCommunityByID(ctx, denom.CommunityId)
+	if !found {
+		return "", 0
+	}
+	return community.Id, community.TreasuryCutBps
+}
+
+// payTreasuryCut sends the treasury cut of an amount paid for an nft from the payer to the treasury of the
+// denom's community and returns the cut. The source is either a primary sale or a royalty.
+func (k Keeper) payTreasuryCut(ctx sdk.Context, payer sdk.AccAddress, denomID, tokenID string, amount sdk.Coin, source string) (sdk.Coin, error) {
+	communityID, cutBps := k.getTreasuryCut(ctx, denomID)
+
+	cut := sdk.NewCoin(amount.Denom, amount.Amount.MulRaw(int64(cutBps)).QuoRaw(types.MaxFeeBps))
+	if !cut.IsPositive() {
+		return cut, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, payer, types.CommunityTreasuryAddress(communityID), sdk.Coins{cut}); err != nil {
+		return cut, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unable to transfer treasury cut %s", err.Error())
+	}
+
+	flow := k.GetTreasuryFlow(ctx, communityID)
+	switch source {
+	case types.TreasurySourcePrimarySale:
+		flow.PrimarySales = flow.PrimarySales.Add(cut)
+	case types.TreasurySourceRoyalty:
+		flow.Royalties = flow.Royalties.Add(cut)
+	}
+	k.SetTreasuryFlow(ctx, flow)
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTreasuryDeposit{
+			Id:      communityID,
+			DenomId: denomID,
+			NftId:   tokenID,
+			Amount:  cut.String(),
+			Source:  source,
+		},
+	)
+	return cut, nil
+}
+
+// GetTreasuryFlow returns the funds that went in and out of a community treasury
+func (k Keeper) GetTreasuryFlow(ctx sdk.Context, communityID string) types.TreasuryFlow {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyTreasuryFlow(communityID))
+	if bz == nil {
+		return types.TreasuryFlow{CommunityId: communityID, PrimarySales: sdk.Coins{}, Royalties: sdk.Coins{}, Spent: sdk.Coins{}}
+	}
+
+	var flow types.TreasuryFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow
+}
+
+func (k Keeper) SetTreasuryFlow(ctx sdk.Context, flow types.TreasuryFlow) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&flow)
+	store.Set(types.KeyTreasuryFlow(flow.CommunityId), bz)
+}
+
+// GetTreasuryFlows returns the treasury flows of every community
+func (k Keeper) GetTreasuryFlows(ctx sdk.Context) (flows []types.TreasuryFlow) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixTreasuryFlow)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.TreasuryFlow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+	return flows
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+func (suite *KeeperSuite) TestSetTreasuryCut() {
+	community := suite.createCommunity("community")
+
+	suite.Require().ErrorIs(suite.keeper.SetTreasuryCut(suite.ctx, community.Id, 1000, address2), types.ErrUnauthorized)
+	suite.Require().ErrorIs(suite.keeper.SetTreasuryCut(suite.ctx, community.Id, types.MaxFeeBps+1, address), types.ErrTreasury)
+	suite.Require().NoError(suite.keeper.SetTreasuryCut(suite.ctx, community.Id, 1000, address))
+
+	community, found := suite.keeper.GetCommunityByID(suite.ctx, community.Id)
+	suite.Require().True(found)
+	suite.Equal(uint32(1000), community.TreasuryCutBps)
+}
+
+func (suite *KeeperSuite) TestTreasuryCutOnRoyalties() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.SetTreasuryCut(suite.ctx, community.Id, 1000, address))
+	err := suite.keeper.CreateDenom(suite.ctx, "communitydenom", "communitydenom", "communitydenom", "", "", address.String(), community.Id,
+		nil, "", false, 0, 0, "", types.PaymentInfo{}, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+
+	suite.mintNFT("communitydenom", tokenID, "0.1", address2, address)
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	suite.Require().NoError(suite.keeper.SellNFT(suite.ctx, tokenID, "communitydenom", "1000stake", address2, nil))
+	suite.Require().NoError(suite.keeper.BuyNFT(suite.ctx, tokenID, "communitydenom", address3))
+
+	// the cut is taken from the royalty, not from the seller
+	treasury := types.CommunityTreasuryAddress(community.Id)
+	suite.Equal(sdk.NewInt(10), suite.balance(treasury))
+	suite.Equal(sdk.NewInt(90), suite.balance(address))
+	suite.Equal(sdk.NewInt(900), suite.balance(address2))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), suite.keeper.GetTreasuryFlow(suite.ctx, community.Id).Royalties)
+}
+
+func (suite *KeeperSuite) TestTreasuryCutOnPrimarySales() {
+	community := suite.createCommunity("community")
+	suite.Require().NoError(suite.keeper.SetTreasuryCut(suite.ctx, community.Id, 1000, address))
+	paymentInfo := types.PaymentInfo{Amount: 100, Currency: sdk.DefaultBondDenom}
+	err := suite.keeper.CreateDenom(suite.ctx, "saledenom", "saledenom", "saledenom", "", "", address.String(), community.Id,
+		nil, "", true, 10, 10, "", paymentInfo, nil, types.TokenGate{})
+	suite.Require().NoError(err)
+
+	suite.fund(address2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	suite.Require().NoError(suite.mintAs("saledenom", tokenID, address2))
+
+	suite.Equal(sdk.NewInt(10), suite.balance(types.CommunityTreasuryAddress(community.Id)))
+	suite.Equal(sdk.NewInt(90), suite.balance(address))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), suite.keeper.GetTreasuryFlow(suite.ctx, community.Id).PrimarySales)
+}
+
+func (suite *KeeperSuite) TestCommunitySpend() {
+	community := suite.createCommunity("community")
+	treasury := types.CommunityTreasuryAddress(community.Id)
+	suite.fund(treasury, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+	// only admins and the owner can spend
+	msg := &types.MsgCommunitySpend{CommunityId: community.Id, Recipient: address3.String(), Amount: amount.String(), Sender: address2.String()}
+	_, err := suite.msgServer.CommunitySpend(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(suite.keeper.GrantCommunityRole(suite.ctx, community.Id, address2, types.RoleAdmin, address))
+	_, err = suite.msgServer.CommunitySpend(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt(60), suite.balance(address3))
+	suite.Equal(amount, suite.keeper.GetTreasuryFlow(suite.ctx, community.Id).Spent)
+
+	_, err = suite.msgServer.CommunitySpend(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrTreasury)
+	suite.Equal(sdk.NewInt(40), suite.balance(treasury))
+}
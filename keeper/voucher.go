@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// RedeemVoucher verifies a mint voucher against the secp256k1 key of the denom creator, mints its nft
+// to the redeemer and pays the creator the voucher price minus the marketplace fee
+func (k Keeper) RedeemVoucher(ctx sdk.Context, voucher types.MintVoucher, signature []byte, redeemer sdk.AccAddress) (sdk.Coin, error) {
+	denom, err := k.GetDenom(ctx, voucher.DenomId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if denom.PrimarySale {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidVoucher, "primary sale denom %s cannot be minted with vouchers", denom.Id)
+	}
+
+	if denom.Badge {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidVoucher, "badge denom %s cannot be minted with vouchers", denom.Id)
+	}
+
+	if voucher.ChainId != ctx.ChainID() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher is for chain %s", voucher.ChainId)
+	}
+
+	if !ctx.BlockTime().Before(voucher.ExpiresAt) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher expired at %s", voucher.ExpiresAt)
+	}
+
+	if k.IsVoucherRedeemed(ctx, voucher.DenomId, voucher.Nonce) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher %d of denom %s is already redeemed", voucher.Nonce, voucher.DenomId)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(denom.Creator)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid denom creator %s", err.Error())
+	}
+
+	if err := k.verifyVoucherSignature(ctx, voucher, signature, creator); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	price, err := voucher.GetPrice()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(voucher.Price) > 0 {
+		if err := k.validatePaymentDenom(ctx, price.Denom); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.useTokenGate(ctx, denom, redeemer); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.MintNFT(ctx,
+		voucher.DenomId,
+		voucher.NftId,
+		voucher.Royalties,
+		voucher.Transferable,
+		redeemer,
+		creator,
+		voucher.Metadata,
+		voucher.Data,
+		voucher.RoyaltyShares,
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetVoucherRedeemed(ctx, voucher.DenomId, voucher.Nonce)
+
+	if price.IsValid() && price.IsPositive() {
+		fee, err := k.payMarketplaceFee(ctx, redeemer, voucher.DenomId, voucher.NftId, sdk.NewDecCoinFromCoin(price))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		cut, err := k.payTreasuryCut(ctx, redeemer, voucher.DenomId, voucher.NftId, price.Sub(fee), types.TreasurySourcePrimarySale)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		proceeds := price.Sub(fee).Sub(cut)
+		if proceeds.IsPositive() {
+			if err := k.bankKeeper.SendCoins(ctx, redeemer, creator, sdk.Coins{proceeds}); err != nil {
+				return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to pay the voucher price %s", err.Error())
+			}
+		}
+	}
+	return price, nil
+}
+
+func (k Keeper) verifyVoucherSignature(ctx sdk.Context, voucher types.MintVoucher, signature []byte, creator sdk.AccAddress) error {
+	account := k.accountKeeper.GetAccount(ctx, creator)
+	if account == nil || account.GetPubKey() == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidVoucher, "denom creator %s has no public key", creator)
+	}
+
+	pubKey, ok := account.GetPubKey().(*secp256k1.PubKey)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidVoucher, "denom creator %s does not have a secp256k1 key", creator)
+	}
+
+	if !pubKey.VerifySignature(voucher.GetSignBytes(), signature) {
+		return sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher is not signed by the denom creator %s", creator)
+	}
+	return nil
+}
+
+// SetVoucherRedeemed marks the voucher nonce of the denom as used
+func (k Keeper) SetVoucherRedeemed(ctx sdk.Context, denomID string, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyVoucherNonce(denomID, nonce), []byte{})
+}
+
+// IsVoucherRedeemed returns true if the voucher nonce of the denom is used
+func (k Keeper) IsVoucherRedeemed(ctx sdk.Context, denomID string, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyVoucherNonce(denomID, nonce))
+}
+
+// GetRedeemedVouchers returns the nonces of every redeemed voucher
+func (k Keeper) GetRedeemedVouchers(ctx sdk.Context) (vouchers []types.RedeemedVoucher) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixVoucherNonce)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, nonce, err := types.SplitKeyVoucherNonce(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		vouchers = append(vouchers, types.RedeemedVoucher{DenomId: denomID, Nonce: nonce})
+	}
+	return vouchers
+}
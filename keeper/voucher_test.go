@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AutonomyNetwork/nft/types"
+)
+
+// voucherCreator creates a denom whose creator account has a secp256k1 public key and returns the key
+func (suite *KeeperSuite) voucherCreator(denomID string) *secp256k1.PrivKey {
+	privKey := secp256k1.GenPrivKey()
+	creator := sdk.AccAddress(privKey.PubKey().Address())
+
+	account := suite.accKeeper.NewAccountWithAddress(suite.ctx, creator)
+	suite.Require().NoError(account.SetPubKey(privKey.PubKey()))
+	suite.accKeeper.SetAccount(suite.ctx, account)
+
+	suite.createDenom(denomID, denomID, creator)
+	return privKey
+}
+
+func (suite *KeeperSuite) newVoucher(denomID, nftID string, nonce uint64) types.MintVoucher {
+	return types.MintVoucher{
+		ChainId:      chainID,
+		DenomId:      denomID,
+		NftId:        nftID,
+		Metadata:     types.Metadata{Name: tokenNm},
+		Transferable: true,
+		Royalties:    "0.1",
+		Price:        "100stake",
+		ExpiresAt:    blockTime.Add(24 * time.Hour),
+		Nonce:        nonce,
+	}
+}
+
+func (suite *KeeperSuite) TestRedeemVoucher() {
+	suite.setFeeBps(500)
+	privKey := suite.voucherCreator("voucherdenom")
+	creator := sdk.AccAddress(privKey.PubKey().Address())
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	voucher := suite.newVoucher("voucherdenom", tokenID, 1)
+	signature, err := privKey.Sign(voucher.GetSignBytes())
+	suite.Require().NoError(err)
+
+	price, err := suite.keeper.RedeemVoucher(suite.ctx, voucher, signature, address3)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), price)
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, "voucherdenom", tokenID)
+	suite.Require().NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+	suite.Equal(creator, nft.GetCreator())
+
+	suite.True(suite.balance(address3).IsZero())
+	suite.Equal(sdk.NewInt(5), suite.balance(suite.keeper.GetFeePoolAddress()))
+	suite.Equal(sdk.NewInt(95), suite.balance(creator))
+	suite.True(suite.keeper.IsVoucherRedeemed(suite.ctx, "voucherdenom", 1))
+
+	// the nonce cannot be replayed, even for another nft
+	replay := suite.newVoucher("voucherdenom", tokenID2, 1)
+	signature, err = privKey.Sign(replay.GetSignBytes())
+	suite.Require().NoError(err)
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, replay, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+}
+
+func (suite *KeeperSuite) TestRedeemVoucherInvalidSignature() {
+	privKey := suite.voucherCreator("voucherdenom")
+	suite.fund(address3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	voucher := suite.newVoucher("voucherdenom", tokenID, 1)
+
+	// signed by another key
+	signature, err := secp256k1.GenPrivKey().Sign(voucher.GetSignBytes())
+	suite.Require().NoError(err)
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, voucher, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	// signed by the creator but redeemed with a changed price
+	signature, err = privKey.Sign(voucher.GetSignBytes())
+	suite.Require().NoError(err)
+	tampered := voucher
+	tampered.Price = "1stake"
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, tampered, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	// signed for another chain
+	other := voucher
+	other.ChainId = "other-chain"
+	signature, err = privKey.Sign(other.GetSignBytes())
+	suite.Require().NoError(err)
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, other, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	// the creator has no public key
+	voucher.DenomId = denomID
+	signature, err = privKey.Sign(voucher.GetSignBytes())
+	suite.Require().NoError(err)
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, voucher, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	suite.False(suite.keeper.HasNFT(suite.ctx, "voucherdenom", tokenID))
+	suite.Equal(sdk.NewInt(100), suite.balance(address3))
+}
+
+func (suite *KeeperSuite) TestRedeemVoucherExpired() {
+	privKey := suite.voucherCreator("voucherdenom")
+
+	voucher := suite.newVoucher("voucherdenom", tokenID, 1)
+	voucher.Price = ""
+	signature, err := privKey.Sign(voucher.GetSignBytes())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(voucher.ExpiresAt)
+	_, err = suite.keeper.RedeemVoucher(suite.ctx, voucher, signature, address3)
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+}
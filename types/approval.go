@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateNFTApproval checks the nft and the spender of a single nft approval
+func ValidateNFTApproval(approval NFTApproval) error {
+	if err := ValidateDenomID(approval.DenomId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(approval.NftId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(approval.Spender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address %s", err)
+	}
+	return nil
+}
+
+// ValidateOperatorApproval checks the addresses and the optional denom of an operator approval
+func ValidateOperatorApproval(approval OperatorApproval) error {
+	if _, err := sdk.AccAddressFromBech32(approval.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(approval.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address %s", err)
+	}
+
+	if approval.Owner == approval.Operator {
+		return sdkerrors.Wrapf(ErrUnauthorized, "%s cannot be its own operator", approval.Owner)
+	}
+
+	if len(approval.DenomId) > 0 {
+		return ValidateDenomID(approval.DenomId)
+	}
+	return nil
+}
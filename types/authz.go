@@ -0,0 +1,181 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &TransferNFTAuthorization{}
+	_ authz.Authorization = &SellNFTAuthorization{}
+	_ authz.Authorization = &MintAuthorization{}
+)
+
+// NewTransferNFTAuthorization creates a new TransferNFTAuthorization object
+func NewTransferNFTAuthorization(denomIDs []string, nfts []NFTRef) *TransferNFTAuthorization {
+	return &TransferNFTAuthorization{
+		DenomIds: denomIDs,
+		NFTs:     nfts,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a TransferNFTAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransferNFT{})
+}
+
+// Accept implements Authorization.Accept. A single nft is removed from the authorization once transferred.
+func (a TransferNFTAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	transfer, ok := msg.(*MsgTransferNFT)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if containsDenom(a.DenomIds, transfer.DenomId) {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	ref := NFTRef{DenomId: transfer.DenomId, NftId: transfer.Id}
+	for i, nft := range a.NFTs {
+		if !nft.Equal(ref) {
+			continue
+		}
+
+		nfts := append(append([]NFTRef{}, a.NFTs[:i]...), a.NFTs[i+1:]...)
+		if len(nfts) == 0 && len(a.DenomIds) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		return authz.AcceptResponse{Accept: true, Updated: NewTransferNFTAuthorization(a.DenomIds, nfts)}, nil
+	}
+	return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrUnauthorized, "nft %s of denom %s is not authorized", transfer.Id, transfer.DenomId)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a TransferNFTAuthorization) ValidateBasic() error {
+	if len(a.DenomIds) == 0 && len(a.NFTs) == 0 {
+		return sdkerrors.Wrap(ErrUnauthorized, "transfer authorization needs at least one denom or nft")
+	}
+
+	if err := validateDenomIDs(a.DenomIds); err != nil {
+		return err
+	}
+
+	for _, nft := range a.NFTs {
+		if err := ValidateDenomID(nft.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateNFTID(nft.NftId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSellNFTAuthorization creates a new SellNFTAuthorization object
+func NewSellNFTAuthorization(denomIDs []string, minPrice sdk.DecCoins) *SellNFTAuthorization {
+	return &SellNFTAuthorization{
+		DenomIds: denomIDs,
+		MinPrice: minPrice,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a SellNFTAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSellNFT{})
+}
+
+// Accept implements Authorization.Accept. Only crypto listings priced in a denom of the min price are accepted.
+func (a SellNFTAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	sell, ok := msg.(*MsgSellNFT)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.DenomIds) > 0 && !containsDenom(a.DenomIds, sell.DenomId) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrUnauthorized, "denom %s is not authorized", sell.DenomId)
+	}
+
+	if sell.ListedType != Crypto {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrUnauthorized, "only crypto listings are authorized")
+	}
+
+	price, err := sdk.ParseDecCoin(sell.Price)
+	if err != nil {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrInvalidPrice, "unable to parse the price %s", err.Error())
+	}
+
+	minPrice := a.MinPrice.AmountOf(price.Denom)
+	if !minPrice.IsPositive() || price.Amount.LT(minPrice) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrInvalidPrice, "price %s is below the authorized minimum %s", price, a.MinPrice)
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a SellNFTAuthorization) ValidateBasic() error {
+	if err := validateDenomIDs(a.DenomIds); err != nil {
+		return err
+	}
+
+	if a.MinPrice.Empty() || !a.MinPrice.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid min price %s", a.MinPrice)
+	}
+	return nil
+}
+
+// NewMintAuthorization creates a new MintAuthorization object
+func NewMintAuthorization(denomIDs []string, remaining uint64) *MintAuthorization {
+	return &MintAuthorization{
+		DenomIds:  denomIDs,
+		Remaining: remaining,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a MintAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMintNFT{})
+}
+
+// Accept implements Authorization.Accept. The authorization is deleted once its quota is used up.
+func (a MintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mint, ok := msg.(*MsgMintNFT)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.DenomIds) > 0 && !containsDenom(a.DenomIds, mint.DenomId) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrUnauthorized, "denom %s is not authorized", mint.DenomId)
+	}
+
+	if a.Remaining <= 1 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{Accept: true, Updated: NewMintAuthorization(a.DenomIds, a.Remaining-1)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a MintAuthorization) ValidateBasic() error {
+	if a.Remaining == 0 {
+		return sdkerrors.Wrap(ErrUnauthorized, "mint authorization quota must be positive")
+	}
+	return validateDenomIDs(a.DenomIds)
+}
+
+func containsDenom(denomIDs []string, denomID string) bool {
+	for _, id := range denomIDs {
+		if id == denomID {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDenomIDs(denomIDs []string) error {
+	for _, id := range denomIDs {
+		if err := ValidateDenomID(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1048 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NFTRef identifies a single nft of a denom
+type NFTRef struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty" yaml:"nft_id"`
+}
+
+func (m *NFTRef) Reset()         { *m = NFTRef{} }
+func (m *NFTRef) String() string { return proto.CompactTextString(m) }
+func (*NFTRef) ProtoMessage()    {}
+func (*NFTRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda65e0fb96e83d2, []int{0}
+}
+func (m *NFTRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTRef.Merge(m, src)
+}
+func (m *NFTRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTRef proto.InternalMessageInfo
+
+// TransferNFTAuthorization allows the grantee to transfer the nfts of the granter in the
+// denoms and the single nfts it lists. A single nft can be transferred once.
+type TransferNFTAuthorization struct {
+	DenomIds []string `protobuf:"bytes,1,rep,name=denom_ids,json=denomIds,proto3" json:"denom_ids,omitempty" yaml:"denom_ids"`
+	NFTs     []NFTRef `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
+}
+
+func (m *TransferNFTAuthorization) Reset()         { *m = TransferNFTAuthorization{} }
+func (m *TransferNFTAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TransferNFTAuthorization) ProtoMessage()    {}
+func (*TransferNFTAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda65e0fb96e83d2, []int{1}
+}
+func (m *TransferNFTAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferNFTAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferNFTAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferNFTAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferNFTAuthorization.Merge(m, src)
+}
+func (m *TransferNFTAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferNFTAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferNFTAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferNFTAuthorization proto.InternalMessageInfo
+
+// SellNFTAuthorization allows the grantee to list the nfts of the granter for at least
+// the min price in the listing denom. An empty denom_ids allows every denom.
+type SellNFTAuthorization struct {
+	DenomIds []string                                    `protobuf:"bytes,1,rep,name=denom_ids,json=denomIds,proto3" json:"denom_ids,omitempty" yaml:"denom_ids"`
+	MinPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_price,json=minPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_price" yaml:"min_price"`
+}
+
+func (m *SellNFTAuthorization) Reset()         { *m = SellNFTAuthorization{} }
+func (m *SellNFTAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SellNFTAuthorization) ProtoMessage()    {}
+func (*SellNFTAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda65e0fb96e83d2, []int{2}
+}
+func (m *SellNFTAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SellNFTAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SellNFTAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SellNFTAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellNFTAuthorization.Merge(m, src)
+}
+func (m *SellNFTAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SellNFTAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellNFTAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellNFTAuthorization proto.InternalMessageInfo
+
+// MintAuthorization allows the grantee to mint up to remaining nfts for the granter.
+// An empty denom_ids allows every denom the granter can mint into.
+type MintAuthorization struct {
+	DenomIds  []string `protobuf:"bytes,1,rep,name=denom_ids,json=denomIds,proto3" json:"denom_ids,omitempty" yaml:"denom_ids"`
+	Remaining uint64   `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *MintAuthorization) Reset()         { *m = MintAuthorization{} }
+func (m *MintAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MintAuthorization) ProtoMessage()    {}
+func (*MintAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda65e0fb96e83d2, []int{3}
+}
+func (m *MintAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAuthorization.Merge(m, src)
+}
+func (m *MintAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAuthorization proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*NFTRef)(nil), "nft.v1beta1.NFTRef")
+	proto.RegisterType((*TransferNFTAuthorization)(nil), "nft.v1beta1.TransferNFTAuthorization")
+	proto.RegisterType((*SellNFTAuthorization)(nil), "nft.v1beta1.SellNFTAuthorization")
+	proto.RegisterType((*MintAuthorization)(nil), "nft.v1beta1.MintAuthorization")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/authz.proto", fileDescriptor_cda65e0fb96e83d2) }
+
+var fileDescriptor_cda65e0fb96e83d2 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xb5, 0x21, 0xc4, 0x57, 0x2a, 0x88, 0x1b, 0x89, 0x50, 0x55, 0x76, 0x64, 0x31, 0x44,
+	0x42, 0xb5, 0x15, 0x10, 0x4b, 0xb6, 0x1a, 0x14, 0xd4, 0x81, 0x08, 0x99, 0x4c, 0x2c, 0x91, 0x63,
+	0x9f, 0x93, 0x53, 0x73, 0xef, 0x22, 0xdf, 0x85, 0x2a, 0x1d, 0xf9, 0x05, 0x6c, 0xac, 0xcc, 0xcc,
+	0xfc, 0x88, 0x88, 0xa9, 0x23, 0x93, 0xa1, 0xce, 0xc2, 0x9c, 0x5f, 0x80, 0xec, 0x73, 0xd2, 0x56,
+	0xea, 0x06, 0x93, 0xef, 0xde, 0xf7, 0xbd, 0xf7, 0xbd, 0xcf, 0x9f, 0x8d, 0x1f, 0x43, 0x2c, 0xdd,
+	0x8f, 0x9d, 0x11, 0x91, 0x41, 0xc7, 0x0d, 0xe6, 0x72, 0x72, 0xe1, 0xcc, 0x12, 0x2e, 0xb9, 0xb1,
+	0x07, 0xb1, 0x74, 0x4a, 0xe0, 0xb0, 0x31, 0xe6, 0x63, 0x5e, 0xd4, 0xdd, 0xfc, 0xa4, 0x28, 0x87,
+	0x4f, 0x42, 0x2e, 0x18, 0x17, 0x43, 0x05, 0xa8, 0x4b, 0x09, 0x99, 0xea, 0xe6, 0x8e, 0x02, 0x41,
+	0xb6, 0xe3, 0x43, 0x4e, 0x41, 0xe1, 0xf6, 0x04, 0x57, 0xfb, 0xbd, 0x81, 0x4f, 0x62, 0xc3, 0xc1,
+	0xb5, 0x88, 0x00, 0x67, 0x43, 0x1a, 0x35, 0x51, 0x0b, 0xb5, 0x75, 0xef, 0x60, 0x9d, 0x5a, 0x0f,
+	0x17, 0x01, 0x9b, 0x76, 0xed, 0x0d, 0x62, 0xfb, 0xf7, 0x8b, 0xe3, 0x69, 0x64, 0xb4, 0x71, 0x15,
+	0x62, 0x99, 0xb3, 0x77, 0x0a, 0x76, 0x7d, 0x9d, 0x5a, 0xfb, 0x8a, 0xad, 0xea, 0xb6, 0x7f, 0x0f,
+	0x62, 0x79, 0x1a, 0x75, 0x2b, 0x7f, 0xbe, 0x5a, 0xc8, 0xfe, 0x82, 0x70, 0x73, 0x90, 0x04, 0x20,
+	0x62, 0x92, 0xf4, 0x7b, 0x83, 0x93, 0xb9, 0x9c, 0xf0, 0x84, 0x5e, 0x04, 0x92, 0x72, 0x30, 0x3a,
+	0x58, 0xdf, 0x48, 0x88, 0x26, 0x6a, 0xed, 0xb6, 0x75, 0xaf, 0xb1, 0x4e, 0xad, 0x47, 0xb7, 0xd5,
+	0x85, 0xed, 0xd7, 0x4a, 0x79, 0x61, 0xbc, 0xc4, 0x15, 0x88, 0xa5, 0x68, 0xee, 0xb4, 0x76, 0xdb,
+	0x7b, 0xcf, 0x0f, 0x9c, 0x1b, 0xaf, 0xc9, 0x51, 0x96, 0xbc, 0x07, 0xcb, 0xd4, 0xd2, 0xb2, 0xd4,
+	0xaa, 0xf4, 0x7b, 0x03, 0xe1, 0x17, 0xf4, 0x6e, 0xfd, 0xc7, 0xf7, 0xe3, 0xfd, 0x5b, 0xe2, 0xf6,
+	0x15, 0xc2, 0x8d, 0xf7, 0x64, 0x3a, 0xfd, 0x1f, 0x5b, 0x7d, 0x42, 0x58, 0x67, 0x14, 0x86, 0xb3,
+	0x84, 0x86, 0xa4, 0xdc, 0xed, 0xc8, 0x29, 0x23, 0xc9, 0x43, 0xd8, 0xee, 0xf8, 0x9a, 0x84, 0xaf,
+	0x38, 0x05, 0xef, 0x4d, 0xbe, 0xe4, 0xf5, 0xd4, 0x6d, 0xb3, 0xfd, 0xed, 0x97, 0xf5, 0x6c, 0x4c,
+	0xe5, 0x64, 0x3e, 0x72, 0x42, 0xce, 0xca, 0x58, 0xcb, 0xc7, 0xb1, 0x88, 0xce, 0x5c, 0xb9, 0x98,
+	0x11, 0xb1, 0x99, 0x23, 0xfc, 0x1a, 0xa3, 0xf0, 0x2e, 0xef, 0xbc, 0xcb, 0xe3, 0x39, 0xae, 0xbf,
+	0xa5, 0x20, 0xff, 0xd9, 0xdf, 0x11, 0xd6, 0x13, 0xc2, 0x02, 0x0a, 0x14, 0xc6, 0x45, 0xf0, 0x15,
+	0xff, 0xba, 0x70, 0x87, 0xb0, 0xe7, 0x2d, 0xaf, 0x4c, 0x6d, 0x99, 0x99, 0xe8, 0x32, 0x33, 0xd1,
+	0xef, 0xcc, 0x44, 0x9f, 0x57, 0xa6, 0x76, 0xb9, 0x32, 0xb5, 0x9f, 0x2b, 0x53, 0xfb, 0xf0, 0xf4,
+	0x86, 0xc1, 0x93, 0xb9, 0xe4, 0xc0, 0xd9, 0xa2, 0x4f, 0xe4, 0x39, 0x4f, 0xce, 0xdc, 0xfc, 0x87,
+	0x28, 0x2c, 0x8e, 0xaa, 0xc5, 0xb7, 0xfa, 0xe2, 0xef, 0x00, 0xc1, 0xc8, 0x34, 0x64, 0x24, 0x03,
+	0x00, 0x00,
+}
+
+func (this *NFTRef) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NFTRef)
+	if !ok {
+		that2, ok := that.(NFTRef)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.NftId != that1.NftId {
+		return false
+	}
+	return true
+}
+func (m *NFTRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferNFTAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NFTs) > 0 {
+		for iNdEx := len(m.NFTs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DenomIds) > 0 {
+		for iNdEx := len(m.DenomIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomIds[iNdEx])
+			copy(dAtA[i:], m.DenomIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SellNFTAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinPrice) > 0 {
+		for iNdEx := len(m.MinPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DenomIds) > 0 {
+		for iNdEx := len(m.DenomIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomIds[iNdEx])
+			copy(dAtA[i:], m.DenomIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomIds) > 0 {
+		for iNdEx := len(m.DenomIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomIds[iNdEx])
+			copy(dAtA[i:], m.DenomIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NFTRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferNFTAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomIds) > 0 {
+		for _, s := range m.DenomIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.NFTs) > 0 {
+		for _, e := range m.NFTs {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SellNFTAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomIds) > 0 {
+		for _, s := range m.DenomIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.MinPrice) > 0 {
+		for _, e := range m.MinPrice {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomIds) > 0 {
+		for _, s := range m.DenomIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovAuthz(uint64(m.Remaining))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NFTRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferNFTAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferNFTAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferNFTAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIds = append(m.DenomIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTs = append(m.NFTs, NFTRef{})
+			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SellNFTAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellNFTAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellNFTAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIds = append(m.DenomIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPrice = append(m.MinPrice, types.DecCoin{})
+			if err := m.MinPrice[len(m.MinPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIds = append(m.DenomIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
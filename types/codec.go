@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	gogotypes "github.com/gogo/protobuf/types"
 	
 	"github.com/AutonomyNetwork/nft/exported"
@@ -21,11 +22,54 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSellNFT{}, "AutonomyNetwork/nft/MsgSellNFT", nil)
 	cdc.RegisterConcrete(&MsgBuyNFT{}, "AutonomyNetwork/nft/MsgBuyNFT", nil)
 	cdc.RegisterConcrete(&MsgCreateCommunity{}, "AutonomyNetwork/nft/MsgCreateCommunity", nil)
+	cdc.RegisterConcrete(&MsgBurnNFT{}, "AutonomyNetwork/nft/MsgBurnNFT", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "AutonomyNetwork/nft/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "AutonomyNetwork/nft/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "AutonomyNetwork/nft/MsgCancelAuction", nil)
+	cdc.RegisterConcrete(&MsgCreateDutchAuction{}, "AutonomyNetwork/nft/MsgCreateDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgMakeOffer{}, "AutonomyNetwork/nft/MsgMakeOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelOffer{}, "AutonomyNetwork/nft/MsgCancelOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, "AutonomyNetwork/nft/MsgAcceptOffer", nil)
+	cdc.RegisterConcrete(&MsgMakeCollectionOffer{}, "AutonomyNetwork/nft/MsgMakeCollectionOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelCollectionOffer{}, "AutonomyNetwork/nft/MsgCancelCollectionOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptCollectionOffer{}, "AutonomyNetwork/nft/MsgAcceptCollectionOffer", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "AutonomyNetwork/nft/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetMintPhases{}, "AutonomyNetwork/nft/MsgSetMintPhases", nil)
+	cdc.RegisterConcrete(&MsgSetAllowlist{}, "AutonomyNetwork/nft/MsgSetAllowlist", nil)
+	cdc.RegisterConcrete(&MsgRedeemVoucher{}, "AutonomyNetwork/nft/MsgRedeemVoucher", nil)
+	cdc.RegisterConcrete(&MsgGrantMinter{}, "AutonomyNetwork/nft/MsgGrantMinter", nil)
+	cdc.RegisterConcrete(&MsgRevokeMinter{}, "AutonomyNetwork/nft/MsgRevokeMinter", nil)
+	cdc.RegisterConcrete(&MsgTransferDenomOwnership{}, "AutonomyNetwork/nft/MsgTransferDenomOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptDenomOwnership{}, "AutonomyNetwork/nft/MsgAcceptDenomOwnership", nil)
+	cdc.RegisterConcrete(&MsgDeleteCommunityRequest{}, "AutonomyNetwork/nft/MsgDeleteCommunity", nil)
+	cdc.RegisterConcrete(&MsgTransferCommunityOwnership{}, "AutonomyNetwork/nft/MsgTransferCommunityOwnership", nil)
+	cdc.RegisterConcrete(&MsgGrantCommunityRole{}, "AutonomyNetwork/nft/MsgGrantCommunityRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeCommunityRole{}, "AutonomyNetwork/nft/MsgRevokeCommunityRole", nil)
+	cdc.RegisterConcrete(&MsgSetMembershipPolicy{}, "AutonomyNetwork/nft/MsgSetMembershipPolicy", nil)
+	cdc.RegisterConcrete(&MsgCreateCommunityInvite{}, "AutonomyNetwork/nft/MsgCreateCommunityInvite", nil)
+	cdc.RegisterConcrete(&MsgApproveJoinRequest{}, "AutonomyNetwork/nft/MsgApproveJoinRequest", nil)
+	cdc.RegisterConcrete(&MsgLeaveCommunity{}, "AutonomyNetwork/nft/MsgLeaveCommunity", nil)
+	cdc.RegisterConcrete(&MsgRemoveMember{}, "AutonomyNetwork/nft/MsgRemoveMember", nil)
+	cdc.RegisterConcrete(&MsgSetTreasuryCut{}, "AutonomyNetwork/nft/MsgSetTreasuryCut", nil)
+	cdc.RegisterConcrete(&MsgCommunitySpend{}, "AutonomyNetwork/nft/MsgCommunitySpend", nil)
+	cdc.RegisterConcrete(&MsgSetCommunityGovernance{}, "AutonomyNetwork/nft/MsgSetCommunityGovernance", nil)
+	cdc.RegisterConcrete(&MsgSubmitCommunityProposal{}, "AutonomyNetwork/nft/MsgSubmitCommunityProposal", nil)
+	cdc.RegisterConcrete(&MsgVoteCommunityProposal{}, "AutonomyNetwork/nft/MsgVoteCommunityProposal", nil)
+	cdc.RegisterConcrete(&MsgCreateBadgeDenom{}, "AutonomyNetwork/nft/MsgCreateBadgeDenom", nil)
+	cdc.RegisterConcrete(&MsgIssueBadge{}, "AutonomyNetwork/nft/MsgIssueBadge", nil)
+	cdc.RegisterConcrete(&MsgRevokeBadge{}, "AutonomyNetwork/nft/MsgRevokeBadge", nil)
+	cdc.RegisterConcrete(&MsgApproveNFT{}, "AutonomyNetwork/nft/MsgApproveNFT", nil)
+	cdc.RegisterConcrete(&MsgRevokeNFTApproval{}, "AutonomyNetwork/nft/MsgRevokeNFTApproval", nil)
+	cdc.RegisterConcrete(&MsgApproveOperator{}, "AutonomyNetwork/nft/MsgApproveOperator", nil)
+	cdc.RegisterConcrete(&MsgRevokeOperator{}, "AutonomyNetwork/nft/MsgRevokeOperator", nil)
 	
 	cdc.RegisterInterface((*exported.NFT)(nil), nil)
 	cdc.RegisterInterface((*exported.MarketPlace)(nil), nil)
 	cdc.RegisterConcrete(&NFT{}, "AutonomyNetwork/nft/NFT", nil)
 	cdc.RegisterConcrete(&MarketPlace{}, "AutonomyNetwork/nft/MarketPlace", nil)
+	cdc.RegisterConcrete(&TransferNFTAuthorization{}, "AutonomyNetwork/nft/TransferNFTAuthorization", nil)
+	cdc.RegisterConcrete(&SellNFTAuthorization{}, "AutonomyNetwork/nft/SellNFTAuthorization", nil)
+	cdc.RegisterConcrete(&MintAuthorization{}, "AutonomyNetwork/nft/MintAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -38,10 +82,58 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBuyNFT{},
 		&MsgCreateCommunity{},
 		&MsgJoinCommunity{},
+		&MsgUpdateCommunity{},
+		&MsgUpdateDenom{},
+		&MsgDeleteMarketPlaceNFT{},
+		&MsgBurnNFT{},
+		&MsgCreateAuction{},
+		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgCreateDutchAuction{},
+		&MsgMakeOffer{},
+		&MsgCancelOffer{},
+		&MsgAcceptOffer{},
+		&MsgMakeCollectionOffer{},
+		&MsgCancelCollectionOffer{},
+		&MsgAcceptCollectionOffer{},
+		&MsgUpdateParams{},
+		&MsgSetMintPhases{},
+		&MsgSetAllowlist{},
+		&MsgRedeemVoucher{},
+		&MsgGrantMinter{},
+		&MsgRevokeMinter{},
+		&MsgTransferDenomOwnership{},
+		&MsgAcceptDenomOwnership{},
+		&MsgDeleteCommunityRequest{},
+		&MsgTransferCommunityOwnership{},
+		&MsgGrantCommunityRole{},
+		&MsgRevokeCommunityRole{},
+		&MsgSetMembershipPolicy{},
+		&MsgCreateCommunityInvite{},
+		&MsgApproveJoinRequest{},
+		&MsgLeaveCommunity{},
+		&MsgRemoveMember{},
+		&MsgSetTreasuryCut{},
+		&MsgCommunitySpend{},
+		&MsgSetCommunityGovernance{},
+		&MsgSubmitCommunityProposal{},
+		&MsgVoteCommunityProposal{},
+		&MsgCreateBadgeDenom{},
+		&MsgIssueBadge{},
+		&MsgRevokeBadge{},
+		&MsgApproveNFT{},
+		&MsgRevokeNFTApproval{},
+		&MsgApproveOperator{},
+		&MsgRevokeOperator{},
 	)
 	
 	registry.RegisterImplementations((*exported.NFT)(nil), &NFT{})
 	registry.RegisterImplementations((*exported.MarketPlace)(nil), &MarketPlace{})
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&TransferNFTAuthorization{},
+		&SellNFTAuthorization{},
+		&MintAuthorization{},
+	)
 	
 }
 
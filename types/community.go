@@ -0,0 +1,78 @@
+package types
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Sources of the funds paid to a community treasury
+const (
+	TreasurySourcePrimarySale = "primary_sale"
+	TreasurySourceRoyalty     = "royalty"
+)
+
+// CommunityTreasuryAddress returns the account that holds the funds of a community
+func CommunityTreasuryAddress(communityID string) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, []byte(communityID)))
+}
+
+// CanCreateDenom returns true if the role can create denoms in the community
+func (r CommunityRole) CanCreateDenom() bool {
+	return r == RoleCreator || r >= RoleAdmin
+}
+
+// CanModerate returns true if the role can manage the members of the community
+func (r CommunityRole) CanModerate() bool {
+	return r >= RoleModerator
+}
+
+// CanManage returns true if the role can update the community
+func (r CommunityRole) CanManage() bool {
+	return r >= RoleAdmin
+}
+
+// CanGrant returns true if the role can grant or revoke the other role. Only the owner
+// manages admins and the owner role itself only changes with an ownership transfer.
+func (r CommunityRole) CanGrant(other CommunityRole) bool {
+	switch r {
+	case RoleOwner:
+		return other < RoleOwner
+	case RoleAdmin:
+		return other < RoleAdmin
+	default:
+		return false
+	}
+}
+
+// IsGrantable returns true if the role can be given with a grant
+func (r CommunityRole) IsGrantable() bool {
+	return r >= RoleMember && r <= RoleAdmin
+}
+
+// ParseCommunityRole parses a community role from its name, e.g. admin, moderator or creator
+func ParseCommunityRole(role string) (CommunityRole, error) {
+	value, ok := CommunityRole_value["COMMUNITY_ROLE_"+strings.ToUpper(strings.TrimSpace(role))]
+	if !ok {
+		return RoleNone, sdkerrors.Wrapf(ErrUnauthorized, "unknown community role %s", role)
+	}
+	return CommunityRole(value), nil
+}
+
+// InviteCodeHash returns the hash an invite code is stored under
+func InviteCodeHash(code string) []byte {
+	hash := sha256.Sum256([]byte(code))
+	return hash[:]
+}
+
+// ParseMembershipPolicy parses a membership policy from its name, e.g. open, approval, invite or token_gated
+func ParseMembershipPolicy(policy string) (MembershipPolicy, error) {
+	value, ok := MembershipPolicy_value["MEMBERSHIP_POLICY_"+strings.ToUpper(strings.TrimSpace(policy))]
+	if !ok {
+		return PolicyOpen, sdkerrors.Wrapf(ErrMembership, "unknown membership policy %s", policy)
+	}
+	return MembershipPolicy(value), nil
+}
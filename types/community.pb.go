@@ -5,18 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +30,113 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VotingWeight is how the votes on community proposals are weighted
+type VotingWeight int32
+
+const (
+	// every member, including the owner, has one vote
+	WeightMember VotingWeight = 0
+	// every nft held of the community's denoms is one vote
+	WeightNFT VotingWeight = 1
+)
+
+var VotingWeight_name = map[int32]string{
+	0: "VOTING_WEIGHT_MEMBER",
+	1: "VOTING_WEIGHT_NFT",
+}
+
+var VotingWeight_value = map[string]int32{
+	"VOTING_WEIGHT_MEMBER": 0,
+	"VOTING_WEIGHT_NFT":    1,
+}
+
+func (x VotingWeight) String() string {
+	return proto.EnumName(VotingWeight_name, int32(x))
+}
+
+func (VotingWeight) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{0}
+}
+
+// MembershipPolicy is how addresses join a community
+type MembershipPolicy int32
+
+const (
+	// anyone can join
+	PolicyOpen MembershipPolicy = 0
+	// joining creates a request a moderator approves
+	PolicyApproval MembershipPolicy = 1
+	// joining needs an invite code created by a moderator
+	PolicyInvite MembershipPolicy = 2
+	// joining needs an nft of the gate denom
+	PolicyTokenGated MembershipPolicy = 3
+)
+
+var MembershipPolicy_name = map[int32]string{
+	0: "MEMBERSHIP_POLICY_OPEN",
+	1: "MEMBERSHIP_POLICY_APPROVAL",
+	2: "MEMBERSHIP_POLICY_INVITE",
+	3: "MEMBERSHIP_POLICY_TOKEN_GATED",
+}
+
+var MembershipPolicy_value = map[string]int32{
+	"MEMBERSHIP_POLICY_OPEN":        0,
+	"MEMBERSHIP_POLICY_APPROVAL":    1,
+	"MEMBERSHIP_POLICY_INVITE":      2,
+	"MEMBERSHIP_POLICY_TOKEN_GATED": 3,
+}
+
+func (x MembershipPolicy) String() string {
+	return proto.EnumName(MembershipPolicy_name, int32(x))
+}
+
+func (MembershipPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{1}
+}
+
+// CommunityRole is the role of an address in a community
+type CommunityRole int32
+
+const (
+	// none is an address outside the community
+	RoleNone   CommunityRole = 0
+	RoleMember CommunityRole = 1
+	// creators can create denoms in the community
+	RoleCreator CommunityRole = 2
+	// moderators manage the members of the community
+	RoleModerator CommunityRole = 3
+	// admins can do everything the owner can except managing other admins
+	RoleAdmin CommunityRole = 4
+	// the owner is the creator of the community
+	RoleOwner CommunityRole = 5
+)
+
+var CommunityRole_name = map[int32]string{
+	0: "COMMUNITY_ROLE_NONE",
+	1: "COMMUNITY_ROLE_MEMBER",
+	2: "COMMUNITY_ROLE_CREATOR",
+	3: "COMMUNITY_ROLE_MODERATOR",
+	4: "COMMUNITY_ROLE_ADMIN",
+	5: "COMMUNITY_ROLE_OWNER",
+}
+
+var CommunityRole_value = map[string]int32{
+	"COMMUNITY_ROLE_NONE":      0,
+	"COMMUNITY_ROLE_MEMBER":    1,
+	"COMMUNITY_ROLE_CREATOR":   2,
+	"COMMUNITY_ROLE_MODERATOR": 3,
+	"COMMUNITY_ROLE_ADMIN":     4,
+	"COMMUNITY_ROLE_OWNER":     5,
+}
+
+func (x CommunityRole) String() string {
+	return proto.EnumName(CommunityRole_name, int32(x))
+}
+
+func (CommunityRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{2}
+}
+
 type Community struct {
 	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,6 +145,21 @@ type Community struct {
 	PreviewURI  string   `protobuf:"bytes,5,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
 	Data        string   `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	Tags        []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// archived communities keep their denoms but accept no new denoms or members
+	Archived         bool             `protobuf:"varint,8,opt,name=archived,proto3" json:"archived,omitempty"`
+	MembershipPolicy MembershipPolicy `protobuf:"varint,9,opt,name=membership_policy,json=membershipPolicy,proto3,enum=nft.v1beta1.MembershipPolicy" json:"membership_policy,omitempty" yaml:"membership_policy"`
+	// gate_denom_id is the denom new members must hold an nft of in token gated communities
+	GateDenomId string `protobuf:"bytes,10,opt,name=gate_denom_id,json=gateDenomId,proto3" json:"gate_denom_id,omitempty" yaml:"gate_denom_id"`
+	// treasury_cut_bps is the share of primary sales and royalties of the community's
+	// denoms paid to the community treasury, in basis points
+	TreasuryCutBps uint32       `protobuf:"varint,11,opt,name=treasury_cut_bps,json=treasuryCutBps,proto3" json:"treasury_cut_bps,omitempty" yaml:"treasury_cut_bps"`
+	VotingWeight   VotingWeight `protobuf:"varint,12,opt,name=voting_weight,json=votingWeight,proto3,enum=nft.v1beta1.VotingWeight" json:"voting_weight,omitempty" yaml:"voting_weight"`
+	// voting_period is how long proposals of the community are open for votes. Zero uses
+	// the default voting period.
+	VotingPeriod time.Duration `protobuf:"bytes,13,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period" yaml:"voting_period"`
+	// quorum_bps is the share of the total voting power that must vote for a proposal to pass,
+	// in basis points
+	QuorumBps uint32 `protobuf:"varint,14,opt,name=quorum_bps,json=quorumBps,proto3" json:"quorum_bps,omitempty" yaml:"quorum_bps"`
 }
 
 func (m *Community) Reset()         { *m = Community{} }
@@ -105,38 +233,264 @@ func (m *CommunityMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityMembers proto.InternalMessageInfo
 
+// CommunityMember is a member of a community with its role
+type CommunityMember struct {
+	CommunityId string        `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	Address     string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role        CommunityRole `protobuf:"varint,3,opt,name=role,proto3,enum=nft.v1beta1.CommunityRole" json:"role,omitempty"`
+}
+
+func (m *CommunityMember) Reset()         { *m = CommunityMember{} }
+func (m *CommunityMember) String() string { return proto.CompactTextString(m) }
+func (*CommunityMember) ProtoMessage()    {}
+func (*CommunityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{2}
+}
+func (m *CommunityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMember.Merge(m, src)
+}
+func (m *CommunityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMember proto.InternalMessageInfo
+
+// JoinRequest is a pending request to join a community with the approval policy
+type JoinRequest struct {
+	CommunityId string    `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	Address     string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RequestedAt time.Time `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3,stdtime" json:"requested_at" yaml:"requested_at"`
+}
+
+func (m *JoinRequest) Reset()         { *m = JoinRequest{} }
+func (m *JoinRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinRequest) ProtoMessage()    {}
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{3}
+}
+func (m *JoinRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinRequest.Merge(m, src)
+}
+func (m *JoinRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinRequest proto.InternalMessageInfo
+
+// CommunityInvite is an invite code of a community stored by its sha256 hash
+type CommunityInvite struct {
+	CommunityId string `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	CodeHash    []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
+	UsesLeft    uint64 `protobuf:"varint,3,opt,name=uses_left,json=usesLeft,proto3" json:"uses_left,omitempty" yaml:"uses_left"`
+}
+
+func (m *CommunityInvite) Reset()         { *m = CommunityInvite{} }
+func (m *CommunityInvite) String() string { return proto.CompactTextString(m) }
+func (*CommunityInvite) ProtoMessage()    {}
+func (*CommunityInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{4}
+}
+func (m *CommunityInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityInvite.Merge(m, src)
+}
+func (m *CommunityInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityInvite proto.InternalMessageInfo
+
+// TreasuryFlow tracks the funds that went in and out of a community treasury
+type TreasuryFlow struct {
+	CommunityId string `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	// primary_sales is the total cut of primary sales paid to the treasury
+	PrimarySales github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=primary_sales,json=primarySales,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"primary_sales" yaml:"primary_sales"`
+	// royalties is the total cut of royalties paid to the treasury
+	Royalties github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=royalties,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"royalties"`
+	// spent is the total spent from the treasury
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *TreasuryFlow) Reset()         { *m = TreasuryFlow{} }
+func (m *TreasuryFlow) String() string { return proto.CompactTextString(m) }
+func (*TreasuryFlow) ProtoMessage()    {}
+func (*TreasuryFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b0374c32d60567f, []int{5}
+}
+func (m *TreasuryFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryFlow.Merge(m, src)
+}
+func (m *TreasuryFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryFlow proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("nft.v1beta1.VotingWeight", VotingWeight_name, VotingWeight_value)
+	proto.RegisterEnum("nft.v1beta1.MembershipPolicy", MembershipPolicy_name, MembershipPolicy_value)
+	proto.RegisterEnum("nft.v1beta1.CommunityRole", CommunityRole_name, CommunityRole_value)
 	proto.RegisterType((*Community)(nil), "nft.v1beta1.Community")
 	proto.RegisterType((*CommunityMembers)(nil), "nft.v1beta1.CommunityMembers")
+	proto.RegisterType((*CommunityMember)(nil), "nft.v1beta1.CommunityMember")
+	proto.RegisterType((*JoinRequest)(nil), "nft.v1beta1.JoinRequest")
+	proto.RegisterType((*CommunityInvite)(nil), "nft.v1beta1.CommunityInvite")
+	proto.RegisterType((*TreasuryFlow)(nil), "nft.v1beta1.TreasuryFlow")
 }
 
 func init() { proto.RegisterFile("nft/v1beta1/community.proto", fileDescriptor_1b0374c32d60567f) }
 
 var fileDescriptor_1b0374c32d60567f = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x6f, 0xe2, 0x30,
-	0x18, 0xc6, 0x93, 0xc0, 0x81, 0x70, 0x4e, 0xa7, 0x93, 0x75, 0x83, 0xc5, 0x9d, 0x02, 0x17, 0x31,
-	0xdc, 0x44, 0x84, 0x6e, 0xeb, 0x56, 0xaa, 0x0e, 0x0c, 0xad, 0x2a, 0x2a, 0x96, 0x2e, 0xc8, 0x89,
-	0x4d, 0x6a, 0x15, 0xc7, 0x91, 0xed, 0x80, 0xf2, 0x2d, 0xfa, 0xb1, 0x18, 0x19, 0xab, 0x0e, 0xa8,
-	0x0d, 0xdf, 0xa0, 0x9f, 0xa0, 0xb2, 0x03, 0x94, 0xed, 0x79, 0x7e, 0xef, 0xa3, 0x57, 0xef, 0x1f,
-	0xf0, 0x3b, 0x5b, 0xe8, 0x68, 0x35, 0x8a, 0xa9, 0xc6, 0xa3, 0x28, 0x11, 0x9c, 0x17, 0x19, 0xd3,
-	0xe5, 0x30, 0x97, 0x42, 0x0b, 0xe8, 0x67, 0x0b, 0x3d, 0x3c, 0x14, 0xbb, 0xbf, 0x52, 0x91, 0x0a,
-	0xcb, 0x23, 0xa3, 0xea, 0x48, 0xb7, 0x97, 0x0a, 0x91, 0x2e, 0x69, 0x64, 0x5d, 0x5c, 0x2c, 0x22,
-	0xcd, 0x38, 0x55, 0x1a, 0xf3, 0xbc, 0x0e, 0x84, 0xaf, 0x2e, 0xe8, 0x5c, 0x1d, 0xfb, 0x42, 0x08,
-	0x9a, 0x19, 0xe6, 0x14, 0xb9, 0x7d, 0xf7, 0x5f, 0x67, 0x6a, 0x35, 0xfc, 0x01, 0x3c, 0x46, 0x90,
-	0x67, 0x89, 0xc7, 0x08, 0x44, 0xa0, 0x9d, 0x48, 0x8a, 0xb5, 0x90, 0xa8, 0x61, 0xe1, 0xd1, 0xc2,
-	0x3e, 0xf0, 0x09, 0x55, 0x89, 0x64, 0xb9, 0x66, 0x22, 0x43, 0x4d, 0x5b, 0x3d, 0x47, 0xf0, 0x1a,
-	0xf8, 0xb9, 0xa4, 0x2b, 0x46, 0xd7, 0xf3, 0x42, 0x32, 0xf4, 0xcd, 0x24, 0xc6, 0x83, 0x6a, 0xd7,
-	0x03, 0x77, 0x35, 0x9e, 0x4d, 0x27, 0x1f, 0xbb, 0x1e, 0x2c, 0x31, 0x5f, 0x5e, 0x84, 0x67, 0xd1,
-	0x70, 0x0a, 0x0e, 0x6e, 0x26, 0x99, 0x19, 0x93, 0x60, 0x8d, 0x51, 0xab, 0x1e, 0xd3, 0x68, 0xc3,
-	0x34, 0x4e, 0x15, 0x6a, 0xf7, 0x1b, 0x86, 0x19, 0x1d, 0xde, 0x83, 0x9f, 0xa7, 0xdd, 0x6e, 0x28,
-	0x8f, 0xa9, 0x54, 0xf0, 0x2f, 0xf8, 0x7e, 0xba, 0xe3, 0x9c, 0x91, 0xc3, 0xaa, 0xfe, 0x89, 0x4d,
-	0x08, 0xfc, 0x03, 0x3a, 0x98, 0x10, 0x49, 0x95, 0xa2, 0x0a, 0x79, 0xb6, 0xdf, 0x17, 0x18, 0x8f,
-	0x37, 0xef, 0x81, 0xb3, 0xa9, 0x02, 0x77, 0x5b, 0x05, 0xee, 0x5b, 0x15, 0xb8, 0xcf, 0xfb, 0xc0,
-	0xd9, 0xee, 0x03, 0xe7, 0x65, 0x1f, 0x38, 0x0f, 0x83, 0x94, 0xe9, 0xc7, 0x22, 0x1e, 0x26, 0x82,
-	0x47, 0x97, 0x85, 0x16, 0x99, 0xe0, 0xe5, 0x2d, 0xd5, 0x6b, 0x21, 0x9f, 0x22, 0xf3, 0x4b, 0x5d,
-	0xe6, 0x54, 0xc5, 0x2d, 0x7b, 0xfc, 0xff, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0xb5, 0x18,
-	0xd0, 0xdf, 0x01, 0x00, 0x00,
+	// 1235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x17, 0x65, 0x39, 0xb1, 0x56, 0x1f, 0xa1, 0x37, 0x4e, 0xc2, 0x30, 0x89, 0xc8, 0x3f, 0x91,
+	0x3f, 0xea, 0xba, 0xa8, 0xd4, 0xb8, 0x05, 0x0a, 0x04, 0xbd, 0x88, 0x36, 0x63, 0xab, 0xb5, 0x49,
+	0x81, 0x51, 0x9c, 0xa6, 0x87, 0xb2, 0x94, 0xb8, 0x96, 0x88, 0x88, 0x5c, 0x86, 0x5c, 0xca, 0xd0,
+	0x1b, 0x04, 0x3a, 0xe5, 0x96, 0x5e, 0x74, 0xca, 0xad, 0xd7, 0x3e, 0x42, 0x2f, 0x39, 0xe6, 0xd6,
+	0x9e, 0x94, 0xc6, 0x79, 0x03, 0x3d, 0x41, 0x41, 0x2e, 0x25, 0x53, 0x52, 0x80, 0xa2, 0x48, 0x7b,
+	0xd2, 0x7c, 0xfc, 0x7e, 0xb3, 0x33, 0x3b, 0x33, 0x2b, 0x82, 0x5b, 0xee, 0x29, 0xa9, 0x0d, 0xee,
+	0xb5, 0x11, 0x31, 0xef, 0xd5, 0x3a, 0xd8, 0x71, 0x42, 0xd7, 0x26, 0xc3, 0xaa, 0xe7, 0x63, 0x82,
+	0x61, 0xc1, 0x3d, 0x25, 0xd5, 0xc4, 0xc9, 0x6f, 0x75, 0x71, 0x17, 0xc7, 0xf6, 0x5a, 0x24, 0x51,
+	0x08, 0x2f, 0x74, 0x31, 0xee, 0xf6, 0x51, 0x2d, 0xd6, 0xda, 0xe1, 0x69, 0x8d, 0xd8, 0x0e, 0x0a,
+	0x88, 0xe9, 0x78, 0x09, 0xa0, 0xb2, 0x0c, 0xb0, 0x42, 0xdf, 0x24, 0x36, 0x76, 0x67, 0xfe, 0x0e,
+	0x0e, 0x1c, 0x1c, 0xd4, 0xda, 0x66, 0x80, 0x52, 0x89, 0xd8, 0x89, 0x5f, 0xfa, 0x7d, 0x1d, 0xe4,
+	0xf7, 0x66, 0x79, 0x41, 0x08, 0x72, 0xae, 0xe9, 0x20, 0x8e, 0x11, 0x99, 0xed, 0xbc, 0x1e, 0xcb,
+	0xb0, 0x0c, 0xb2, 0xb6, 0xc5, 0x65, 0x63, 0x4b, 0xd6, 0xb6, 0x20, 0x07, 0x2e, 0x77, 0x7c, 0x64,
+	0x12, 0xec, 0x73, 0x6b, 0xb1, 0x71, 0xa6, 0x42, 0x11, 0x14, 0x2c, 0x14, 0x74, 0x7c, 0xdb, 0x8b,
+	0x12, 0xe0, 0x72, 0xb1, 0x37, 0x6d, 0x82, 0x0a, 0x28, 0x78, 0x3e, 0x1a, 0xd8, 0xe8, 0xcc, 0x08,
+	0x7d, 0x9b, 0x5b, 0x8f, 0x10, 0xf2, 0xdd, 0xf3, 0x89, 0x00, 0x9a, 0xd4, 0xfc, 0x48, 0x6f, 0x4c,
+	0x27, 0x02, 0x1c, 0x9a, 0x4e, 0xff, 0xbe, 0x94, 0x82, 0x4a, 0x3a, 0x48, 0xb4, 0x47, 0xbe, 0x1d,
+	0xa5, 0x69, 0x99, 0xc4, 0xe4, 0x2e, 0xd1, 0x34, 0x23, 0x39, 0xb2, 0x11, 0xb3, 0x1b, 0x70, 0x97,
+	0xc5, 0xb5, 0xc8, 0x16, 0xc9, 0x90, 0x07, 0x1b, 0xa6, 0xdf, 0xe9, 0xd9, 0x03, 0x64, 0x71, 0x1b,
+	0x22, 0xb3, 0xbd, 0xa1, 0xcf, 0x75, 0xd8, 0x03, 0x9b, 0x0e, 0x72, 0xda, 0xc8, 0x0f, 0x7a, 0xb6,
+	0x67, 0x78, 0xb8, 0x6f, 0x77, 0x86, 0x5c, 0x5e, 0x64, 0xb6, 0xcb, 0xbb, 0x77, 0xaa, 0xa9, 0xc6,
+	0x54, 0x8f, 0xe7, 0xa8, 0x66, 0x0c, 0x92, 0x6f, 0x4f, 0x27, 0x02, 0x47, 0x33, 0x5c, 0x89, 0x20,
+	0xe9, 0xac, 0xb3, 0x84, 0x87, 0xdf, 0x80, 0x52, 0xd7, 0x24, 0xc8, 0xb0, 0x90, 0x8b, 0x1d, 0xc3,
+	0xb6, 0x38, 0x10, 0x97, 0xcd, 0x4d, 0x27, 0xc2, 0x16, 0x0d, 0xb3, 0xe0, 0x96, 0xf4, 0x42, 0xa4,
+	0xef, 0x47, 0x6a, 0xc3, 0x82, 0x0a, 0x60, 0x89, 0x8f, 0xcc, 0x20, 0xf4, 0x87, 0x46, 0x27, 0x24,
+	0x46, 0xdb, 0x0b, 0xb8, 0x82, 0xc8, 0x6c, 0x97, 0xe4, 0x5b, 0xd3, 0x89, 0x70, 0x83, 0x06, 0x58,
+	0x46, 0x48, 0x7a, 0x79, 0x66, 0xda, 0x0b, 0x89, 0xec, 0x05, 0xf0, 0x7b, 0x50, 0x1a, 0x60, 0x62,
+	0xbb, 0x5d, 0xe3, 0x0c, 0xd9, 0xdd, 0x1e, 0xe1, 0x8a, 0x71, 0xa9, 0x37, 0x17, 0x4a, 0x3d, 0x89,
+	0x11, 0x8f, 0x63, 0x40, 0x3a, 0xbf, 0x05, 0xa6, 0xa4, 0x17, 0x07, 0x29, 0x1c, 0xfc, 0x69, 0x1e,
+	0xd9, 0x43, 0xbe, 0x8d, 0x2d, 0xae, 0x24, 0x32, 0xdb, 0x85, 0xdd, 0x9b, 0x55, 0x3a, 0x99, 0xd5,
+	0xd9, 0x64, 0x56, 0xf7, 0x93, 0xc9, 0x94, 0xc5, 0xd7, 0x13, 0x21, 0xb3, 0x12, 0x9d, 0xb2, 0xa5,
+	0x9f, 0xdf, 0x0a, 0xcc, 0xec, 0x84, 0x66, 0x6c, 0x82, 0x5f, 0x01, 0xf0, 0x2c, 0xc4, 0x7e, 0xe8,
+	0xc4, 0xc5, 0x97, 0xe3, 0xe2, 0xaf, 0x4d, 0x27, 0xc2, 0x26, 0xe5, 0x5f, 0xf8, 0x24, 0x3d, 0x4f,
+	0x15, 0xd9, 0x0b, 0xa4, 0x87, 0x80, 0x9d, 0x0f, 0x76, 0xd2, 0x43, 0xf8, 0x3f, 0x50, 0x9c, 0x2f,
+	0x61, 0xd4, 0x09, 0x3a, 0xe7, 0x85, 0xb9, 0xad, 0x61, 0xc1, 0xdb, 0x20, 0x6f, 0x5a, 0x96, 0x8f,
+	0x82, 0x00, 0x05, 0x5c, 0x36, 0x1e, 0xa6, 0x0b, 0x83, 0xf4, 0x92, 0x01, 0x57, 0x96, 0xa2, 0xc2,
+	0xfb, 0x1f, 0x0a, 0x2a, 0xdf, 0x98, 0x4e, 0x84, 0xab, 0x34, 0xc1, 0xb4, 0x57, 0x5a, 0x3c, 0x8d,
+	0x03, 0x97, 0x93, 0xe0, 0xc9, 0x86, 0xcd, 0x54, 0x58, 0x05, 0x39, 0x1f, 0xf7, 0x51, 0xbc, 0x63,
+	0xe5, 0x5d, 0x7e, 0xa1, 0x4f, 0xf3, 0x0c, 0x74, 0xdc, 0x47, 0x7a, 0x8c, 0x93, 0x7e, 0x63, 0x40,
+	0xe1, 0x5b, 0x6c, 0xbb, 0x3a, 0x7a, 0x16, 0xa2, 0x80, 0xfc, 0x47, 0x59, 0xfd, 0x08, 0x8a, 0x3e,
+	0x3d, 0x00, 0x59, 0x86, 0x49, 0xe2, 0xec, 0x0a, 0xbb, 0xfc, 0x4a, 0xaf, 0x5b, 0xb3, 0x67, 0x4a,
+	0x16, 0x92, 0x66, 0x27, 0xa7, 0xa6, 0xd9, 0xd2, 0x8b, 0xa8, 0xd7, 0x85, 0xb9, 0xa9, 0x4e, 0xa4,
+	0x5f, 0xd3, 0xf7, 0xdb, 0x70, 0x07, 0x36, 0x41, 0x1f, 0x55, 0xc9, 0x3d, 0x90, 0xef, 0x60, 0x0b,
+	0x19, 0x3d, 0x33, 0xe8, 0xc5, 0xb5, 0x14, 0xe5, 0xad, 0xe9, 0x44, 0x60, 0x67, 0xc4, 0xc4, 0x25,
+	0xe9, 0x1b, 0x91, 0x7c, 0x68, 0x06, 0xbd, 0x88, 0x12, 0x06, 0x28, 0x30, 0xfa, 0xe8, 0x94, 0xd6,
+	0x97, 0x4b, 0x53, 0xe6, 0x2e, 0x49, 0xdf, 0x88, 0xe4, 0xa3, 0x48, 0x7c, 0xb5, 0x06, 0x8a, 0xad,
+	0x64, 0xdf, 0x1e, 0xf4, 0xf1, 0xd9, 0x47, 0xa5, 0xfc, 0x9c, 0x01, 0x25, 0xcf, 0xb7, 0x1d, 0xd3,
+	0x1f, 0x1a, 0x81, 0xd9, 0x4f, 0xa6, 0x30, 0x5a, 0x28, 0xfa, 0x94, 0x57, 0xa3, 0xa7, 0x3c, 0x35,
+	0x0a, 0xb6, 0x2b, 0x1f, 0x2e, 0x2e, 0xd4, 0x02, 0x5b, 0xfa, 0xe5, 0xad, 0xb0, 0xdd, 0xb5, 0x49,
+	0x2f, 0x6c, 0x57, 0x3b, 0xd8, 0xa9, 0x25, 0xff, 0x07, 0xf4, 0xe7, 0xf3, 0xc0, 0x7a, 0x5a, 0x23,
+	0x43, 0x0f, 0x05, 0x71, 0xa0, 0x40, 0x2f, 0x26, 0xdc, 0x87, 0x11, 0x15, 0xda, 0x20, 0xef, 0xe3,
+	0xa1, 0xd9, 0x27, 0x36, 0x0a, 0xb8, 0xb5, 0xbf, 0xcb, 0xe2, 0x8b, 0x28, 0x8b, 0x7f, 0x74, 0xda,
+	0x45, 0x74, 0x68, 0x82, 0xf5, 0xc0, 0x43, 0x2e, 0xe1, 0x72, 0xff, 0xfe, 0x31, 0x34, 0xf2, 0xce,
+	0x29, 0x28, 0xa6, 0x1f, 0x38, 0xb8, 0x03, 0xb6, 0x4e, 0xb4, 0x56, 0x43, 0x3d, 0x30, 0x1e, 0x2b,
+	0x8d, 0x83, 0xc3, 0x96, 0x71, 0xac, 0x1c, 0xcb, 0x8a, 0xce, 0x66, 0x78, 0x76, 0x34, 0x16, 0x8b,
+	0x14, 0x95, 0xec, 0xf8, 0x5d, 0xb0, 0xb9, 0x88, 0x55, 0x1f, 0xb4, 0x58, 0x86, 0x2f, 0x8d, 0xc6,
+	0x62, 0x9e, 0x02, 0xd5, 0x07, 0x2d, 0x3e, 0xf7, 0xfc, 0x55, 0x25, 0xb3, 0xf3, 0x8e, 0x01, 0xec,
+	0xf2, 0x9f, 0x06, 0xdc, 0x01, 0xd7, 0x69, 0xf8, 0x87, 0x87, 0x8d, 0xa6, 0xd1, 0xd4, 0x8e, 0x1a,
+	0x7b, 0x4f, 0x0c, 0xad, 0xa9, 0xa8, 0x6c, 0x86, 0x2f, 0x8f, 0xc6, 0x22, 0xa0, 0x38, 0xcd, 0x43,
+	0x2e, 0xdc, 0x05, 0xfc, 0x2a, 0xb6, 0xde, 0x6c, 0xea, 0xda, 0x49, 0xfd, 0x88, 0x65, 0x78, 0x38,
+	0x1a, 0x8b, 0x65, 0x8a, 0xaf, 0x7b, 0x9e, 0x8f, 0x07, 0x66, 0x1f, 0x56, 0x01, 0xb7, 0xca, 0x69,
+	0xa8, 0x27, 0x8d, 0x96, 0xc2, 0x66, 0x69, 0x41, 0x94, 0x91, 0x2c, 0xd5, 0xd7, 0xe0, 0xce, 0x2a,
+	0xbe, 0xa5, 0x7d, 0xa7, 0xa8, 0xc6, 0x41, 0xbd, 0xa5, 0xec, 0xb3, 0x6b, 0xfc, 0xd6, 0x68, 0x2c,
+	0xb2, 0x94, 0xd4, 0xc2, 0x4f, 0x91, 0x7b, 0x60, 0x12, 0x64, 0x25, 0x35, 0xbe, 0xcc, 0x82, 0xd2,
+	0xc2, 0x2b, 0x04, 0xff, 0x0f, 0xae, 0xee, 0x69, 0xc7, 0xc7, 0x8f, 0xd4, 0x46, 0xeb, 0x89, 0xa1,
+	0x6b, 0x47, 0x8a, 0xa1, 0x6a, 0xaa, 0xc2, 0x66, 0xf8, 0xe2, 0x68, 0x2c, 0x6e, 0x44, 0x10, 0x15,
+	0xbb, 0x08, 0x7e, 0x0a, 0xae, 0x2d, 0xc1, 0x92, 0x5b, 0x67, 0xe8, 0x35, 0x44, 0xc0, 0xe4, 0xce,
+	0x3f, 0x03, 0xd7, 0x97, 0xa0, 0x7b, 0xba, 0x52, 0x6f, 0x69, 0x3a, 0x9b, 0xe5, 0xaf, 0x8c, 0xc6,
+	0x62, 0x21, 0xc2, 0xee, 0x25, 0xdf, 0x1e, 0x35, 0xc0, 0x2d, 0xc7, 0xd5, 0xf6, 0x15, 0x3d, 0x86,
+	0xaf, 0xf1, 0x9b, 0xa3, 0xb1, 0x58, 0x8a, 0x43, 0x63, 0x0b, 0xf9, 0x31, 0xe1, 0x13, 0xb0, 0xb5,
+	0x44, 0xa8, 0xef, 0x1f, 0x37, 0x54, 0x36, 0x47, 0x9b, 0x1a, 0x81, 0xeb, 0x96, 0x63, 0xbb, 0x1f,
+	0x00, 0x6a, 0x8f, 0x55, 0x45, 0x67, 0xd7, 0x2f, 0x80, 0xda, 0x99, 0x8b, 0x7c, 0x7a, 0x33, 0xb2,
+	0xfc, 0xfa, 0x5d, 0x25, 0xf3, 0xfa, 0xbc, 0xc2, 0xbc, 0x39, 0xaf, 0x30, 0x7f, 0x9e, 0x57, 0x98,
+	0x17, 0xef, 0x2b, 0x99, 0x37, 0xef, 0x2b, 0x99, 0x3f, 0xde, 0x57, 0x32, 0x3f, 0xdc, 0x4d, 0x0d,
+	0x6d, 0x3d, 0x24, 0xd8, 0xc5, 0xce, 0x50, 0x45, 0xe4, 0x0c, 0xfb, 0x4f, 0x6b, 0xd1, 0xa7, 0x62,
+	0x3c, 0xb6, 0xed, 0x4b, 0xf1, 0x3b, 0xfa, 0xe5, 0x5f, 0x03, 0x00, 0x52, 0xcc, 0xae, 0xb3, 0x3e,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *Community) Marshal() (dAtA []byte, err error) {
@@ -159,6 +513,51 @@ func (m *Community) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QuorumBps != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.QuorumBps))
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCommunity(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if m.VotingWeight != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.VotingWeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TreasuryCutBps != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.TreasuryCutBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.GateDenomId) > 0 {
+		i -= len(m.GateDenomId)
+		copy(dAtA[i:], m.GateDenomId)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.GateDenomId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MembershipPolicy != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.MembershipPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Tags) > 0 {
 		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Tags[iNdEx])
@@ -252,82 +651,943 @@ func (m *CommunityMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCommunity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommunity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CommunityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Community) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CommunityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PreviewURI)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *JoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RequestedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedAt):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	i -= n2
+	i = encodeVarintCommunity(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovCommunity(uint64(l))
-		}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommunityMembers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CommunityId)
-	if l > 0 {
-		n += 1 + l + sovCommunity(uint64(l))
+	if m.UsesLeft != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.UsesLeft))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovCommunity(uint64(l))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommunity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommunity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PrimarySales) > 0 {
+		for iNdEx := len(m.PrimarySales) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrimarySales[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommunity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommunity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommunity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Community) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.PreviewURI)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovCommunity(uint64(l))
+		}
+	}
+	if m.Archived {
+		n += 2
+	}
+	if m.MembershipPolicy != 0 {
+		n += 1 + sovCommunity(uint64(m.MembershipPolicy))
+	}
+	l = len(m.GateDenomId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.TreasuryCutBps != 0 {
+		n += 1 + sovCommunity(uint64(m.TreasuryCutBps))
+	}
+	if m.VotingWeight != 0 {
+		n += 1 + sovCommunity(uint64(m.VotingWeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovCommunity(uint64(l))
+	if m.QuorumBps != 0 {
+		n += 1 + sovCommunity(uint64(m.QuorumBps))
+	}
+	return n
+}
+
+func (m *CommunityMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovCommunity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCommunity(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *JoinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedAt)
+	n += 1 + l + sovCommunity(uint64(l))
+	return n
+}
+
+func (m *CommunityInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.UsesLeft != 0 {
+		n += 1 + sovCommunity(uint64(m.UsesLeft))
+	}
+	return n
+}
+
+func (m *TreasuryFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if len(m.PrimarySales) > 0 {
+		for _, e := range m.PrimarySales {
+			l = e.Size()
+			n += 1 + l + sovCommunity(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovCommunity(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovCommunity(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCommunity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommunity(x uint64) (n int) {
+	return sovCommunity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Community) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Community: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Community: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipPolicy", wireType)
+			}
+			m.MembershipPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipPolicy |= MembershipPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GateDenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GateDenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryCutBps", wireType)
+			}
+			m.TreasuryCutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryCutBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWeight", wireType)
+			}
+			m.VotingWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingWeight |= VotingWeight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumBps", wireType)
+			}
+			m.QuorumBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovCommunity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCommunity(x uint64) (n int) {
-	return sovCommunity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Community) Unmarshal(dAtA []byte) error {
+func (m *CommunityMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -350,15 +1610,15 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Community: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Community: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -386,11 +1646,11 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -418,11 +1678,80 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= CommunityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -450,11 +1779,11 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -482,13 +1811,13 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommunity
@@ -498,27 +1827,78 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCommunity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommunity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -546,13 +1926,13 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommunity
@@ -562,24 +1942,45 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCommunity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommunity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsesLeft", wireType)
+			}
+			m.UsesLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsesLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommunity(dAtA[iNdEx:])
@@ -601,7 +2002,7 @@ func (m *Community) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityMembers) Unmarshal(dAtA []byte) error {
+func (m *TreasuryFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -624,10 +2025,10 @@ func (m *CommunityMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -664,9 +2065,9 @@ func (m *CommunityMembers) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySales", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommunity
@@ -676,23 +2077,93 @@ func (m *CommunityMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCommunity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommunity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.PrimarySales = append(m.PrimarySales, types.Coin{})
+			if err := m.PrimarySales[len(m.PrimarySales)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, types.Coin{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
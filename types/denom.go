@@ -3,11 +3,12 @@ package types
 import (
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewDenom return a new denom
-func NewDenom(id, name, symbol, description, preview_uri, creator, community_id string, denoms []string, category string, primarySale bool, totoalNfts, availableNfts int64, data string, paymentInfo PaymentInfo) Denom {
+func NewDenom(id, name, symbol, description, preview_uri, creator, community_id string, denoms []string, category string, primarySale bool, totoalNfts, availableNfts int64, data string, paymentInfo PaymentInfo, royaltyShares []RoyaltyShare, tokenGate TokenGate) Denom {
 	return Denom{
 		Id:              id,
 		Name:            name,
@@ -23,6 +24,8 @@ func NewDenom(id, name, symbol, description, preview_uri, creator, community_id
 		AvailableNfts:   availableNfts,
 		Data:            data,
 		PaymentInfo:     paymentInfo,
+		RoyaltyShares:   royaltyShares,
+		TokenGate:       tokenGate,
 	}
 }
 
@@ -48,3 +51,24 @@ func ValidateDenomSymbol(denomSymbol string) error {
 	}
 	return nil
 }
+
+// GetPrice returns the price a minter pays for an nft of a primary sale denom
+func (p PaymentInfo) GetPrice() (sdk.Coin, error) {
+	if p.Amount < 0 {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidPrice, "primary sale amount cannot be negative: %d", p.Amount)
+	}
+
+	if p.Amount == 0 {
+		return sdk.Coin{}, nil
+	}
+
+	if err := sdk.ValidateDenom(p.Currency); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidPrice, "invalid primary sale currency %s", err.Error())
+	}
+	return sdk.NewInt64Coin(p.Currency, p.Amount), nil
+}
+
+// IsFree returns true if minting from the primary sale denom costs nothing
+func (p PaymentInfo) IsFree() bool {
+	return p.Amount == 0
+}
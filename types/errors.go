@@ -23,4 +23,29 @@ var (
 	ErrInvalidDescription = sdkerrors.Register(ModuleName, 127, "invalid description")
 	ErrDenomNotFound      = sdkerrors.Register(ModuleName, 128, "denom not found")
 	ErrInvalidTotalNFTs   = sdkerrors.Register(ModuleName, 129, "zero total nfts")
+	ErrListedNFT          = sdkerrors.Register(ModuleName, 130, "nft is listed in market place")
+	ErrUnknownAuction     = sdkerrors.Register(ModuleName, 131, "unknown auction")
+	ErrAuctionClosed      = sdkerrors.Register(ModuleName, 132, "auction is closed")
+	ErrInvalidBid         = sdkerrors.Register(ModuleName, 133, "invalid bid")
+	ErrInvalidPrice       = sdkerrors.Register(ModuleName, 134, "invalid price")
+	ErrUnknownOffer       = sdkerrors.Register(ModuleName, 135, "unknown offer")
+	ErrInvalidOffer       = sdkerrors.Register(ModuleName, 136, "invalid offer")
+	ErrListingExpired     = sdkerrors.Register(ModuleName, 137, "listing expired")
+	ErrInvalidDuration    = sdkerrors.Register(ModuleName, 138, "invalid listing duration")
+	ErrInvalidPayment     = sdkerrors.Register(ModuleName, 139, "payment denom not allowed")
+	ErrInvalidRoyalty     = sdkerrors.Register(ModuleName, 140, "invalid royalty")
+	ErrInvalidMintPhase   = sdkerrors.Register(ModuleName, 141, "invalid mint phase")
+	ErrNoActivePhase      = sdkerrors.Register(ModuleName, 142, "no active mint phase")
+	ErrNotAllowlisted     = sdkerrors.Register(ModuleName, 143, "address is not allowlisted")
+	ErrMintLimit          = sdkerrors.Register(ModuleName, 144, "mint limit reached")
+	ErrInvalidVoucher     = sdkerrors.Register(ModuleName, 145, "invalid mint voucher")
+	ErrTokenGate          = sdkerrors.Register(ModuleName, 146, "token gate not satisfied")
+	ErrUnknownMinter      = sdkerrors.Register(ModuleName, 147, "unknown minter")
+	ErrUnknownTransfer    = sdkerrors.Register(ModuleName, 148, "unknown denom ownership transfer")
+	ErrMembership         = sdkerrors.Register(ModuleName, 149, "community membership not allowed")
+	ErrTreasury           = sdkerrors.Register(ModuleName, 150, "invalid community treasury operation")
+	ErrUnknownProposal    = sdkerrors.Register(ModuleName, 151, "unknown community proposal")
+	ErrInvalidProposal    = sdkerrors.Register(ModuleName, 152, "invalid community proposal")
+	ErrInvalidBadge       = sdkerrors.Register(ModuleName, 153, "invalid community badge")
+	ErrUnknownApproval    = sdkerrors.Register(ModuleName, 154, "unknown nft approval")
 )
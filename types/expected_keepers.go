@@ -10,6 +10,7 @@ import (
 // AccountKeeper defines the expected account keeper for query account
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
 }
 type (
 	// BankKeeper defines the expected interface needed to retrieve account balances.
@@ -17,6 +18,9 @@ type (
 		keeper.SendKeeper
 		GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 		
 	}
 )
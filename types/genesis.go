@@ -1,10 +1,31 @@
 package types
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(collections []Collection, orders []MarketPlace, communitites []Community) *GenesisState {
+func NewGenesisState(collections []Collection, orders []MarketPlace, communitites []Community, auctions []Auction, dutchAuctions []DutchAuction, offers []Offer, collectionOffers []CollectionOffer, params Params, collectedFees []CollectedFees, mintPhases []MintPhase, allowlists []PhaseAllowlist, walletMints []WalletMints, redeemedVouchers []RedeemedVoucher, lockedGateTokens []LockedGateToken, minters []Minter, denomTransfers []DenomOwnershipTransfer, communityMembers []CommunityMember, joinRequests []JoinRequest, communityInvites []CommunityInvite, treasuryFlows []TreasuryFlow, communityProposals []CommunityProposal, communityVotes []CommunityVote, nftApprovals []NFTApproval, operatorApprovals []OperatorApproval) *GenesisState {
 	return &GenesisState{
-		Collections: collections,
-		Orders:      orders,
-		Communities: communitites,
+		Collections:        collections,
+		Orders:             orders,
+		Communities:        communitites,
+		Auctions:           auctions,
+		DutchAuctions:      dutchAuctions,
+		Offers:             offers,
+		CollectionOffers:   collectionOffers,
+		Params:             params,
+		CollectedFees:      collectedFees,
+		MintPhases:         mintPhases,
+		Allowlists:         allowlists,
+		WalletMints:        walletMints,
+		RedeemedVouchers:   redeemedVouchers,
+		LockedGateTokens:   lockedGateTokens,
+		Minters:            minters,
+		DenomTransfers:     denomTransfers,
+		CommunityMembers:   communityMembers,
+		JoinRequests:       joinRequests,
+		CommunityInvites:   communityInvites,
+		TreasuryFlows:      treasuryFlows,
+		CommunityProposals: communityProposals,
+		CommunityVotes:     communityVotes,
+		NFTApprovals:       nftApprovals,
+		OperatorApprovals:  operatorApprovals,
 	}
 }
@@ -25,9 +25,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the nft module's genesis state.
 type GenesisState struct {
-	Collections []Collection  `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
-	Orders      []MarketPlace `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
-	Communities []Community   `protobuf:"bytes,3,rep,name=communities,proto3" json:"communities"`
+	Collections        []Collection             `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
+	Orders             []MarketPlace            `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	Communities        []Community              `protobuf:"bytes,3,rep,name=communities,proto3" json:"communities"`
+	Auctions           []Auction                `protobuf:"bytes,4,rep,name=auctions,proto3" json:"auctions"`
+	DutchAuctions      []DutchAuction           `protobuf:"bytes,5,rep,name=dutch_auctions,json=dutchAuctions,proto3" json:"dutch_auctions"`
+	Offers             []Offer                  `protobuf:"bytes,6,rep,name=offers,proto3" json:"offers"`
+	CollectionOffers   []CollectionOffer        `protobuf:"bytes,7,rep,name=collection_offers,json=collectionOffers,proto3" json:"collection_offers"`
+	Params             Params                   `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	CollectedFees      []CollectedFees          `protobuf:"bytes,9,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
+	MintPhases         []MintPhase              `protobuf:"bytes,10,rep,name=mint_phases,json=mintPhases,proto3" json:"mint_phases"`
+	Allowlists         []PhaseAllowlist         `protobuf:"bytes,11,rep,name=allowlists,proto3" json:"allowlists"`
+	WalletMints        []WalletMints            `protobuf:"bytes,12,rep,name=wallet_mints,json=walletMints,proto3" json:"wallet_mints"`
+	RedeemedVouchers   []RedeemedVoucher        `protobuf:"bytes,13,rep,name=redeemed_vouchers,json=redeemedVouchers,proto3" json:"redeemed_vouchers"`
+	LockedGateTokens   []LockedGateToken        `protobuf:"bytes,14,rep,name=locked_gate_tokens,json=lockedGateTokens,proto3" json:"locked_gate_tokens"`
+	Minters            []Minter                 `protobuf:"bytes,15,rep,name=minters,proto3" json:"minters"`
+	DenomTransfers     []DenomOwnershipTransfer `protobuf:"bytes,16,rep,name=denom_transfers,json=denomTransfers,proto3" json:"denom_transfers"`
+	CommunityMembers   []CommunityMember        `protobuf:"bytes,17,rep,name=community_members,json=communityMembers,proto3" json:"community_members"`
+	JoinRequests       []JoinRequest            `protobuf:"bytes,18,rep,name=join_requests,json=joinRequests,proto3" json:"join_requests"`
+	CommunityInvites   []CommunityInvite        `protobuf:"bytes,19,rep,name=community_invites,json=communityInvites,proto3" json:"community_invites"`
+	TreasuryFlows      []TreasuryFlow           `protobuf:"bytes,20,rep,name=treasury_flows,json=treasuryFlows,proto3" json:"treasury_flows"`
+	CommunityProposals []CommunityProposal      `protobuf:"bytes,21,rep,name=community_proposals,json=communityProposals,proto3" json:"community_proposals"`
+	CommunityVotes     []CommunityVote          `protobuf:"bytes,22,rep,name=community_votes,json=communityVotes,proto3" json:"community_votes"`
+	NFTApprovals       []NFTApproval            `protobuf:"bytes,23,rep,name=nft_approvals,json=nftApprovals,proto3" json:"nft_approvals"`
+	OperatorApprovals  []OperatorApproval       `protobuf:"bytes,24,rep,name=operator_approvals,json=operatorApprovals,proto3" json:"operator_approvals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +105,153 @@ func (m *GenesisState) GetCommunities() []Community {
 	return nil
 }
 
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDutchAuctions() []DutchAuction {
+	if m != nil {
+		return m.DutchAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOffers() []Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollectionOffers() []CollectionOffer {
+	if m != nil {
+		return m.CollectionOffers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetCollectedFees() []CollectedFees {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintPhases() []MintPhase {
+	if m != nil {
+		return m.MintPhases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlists() []PhaseAllowlist {
+	if m != nil {
+		return m.Allowlists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWalletMints() []WalletMints {
+	if m != nil {
+		return m.WalletMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedeemedVouchers() []RedeemedVoucher {
+	if m != nil {
+		return m.RedeemedVouchers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedGateTokens() []LockedGateToken {
+	if m != nil {
+		return m.LockedGateTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTransfers() []DenomOwnershipTransfer {
+	if m != nil {
+		return m.DenomTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityMembers() []CommunityMember {
+	if m != nil {
+		return m.CommunityMembers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJoinRequests() []JoinRequest {
+	if m != nil {
+		return m.JoinRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityInvites() []CommunityInvite {
+	if m != nil {
+		return m.CommunityInvites
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryFlows() []TreasuryFlow {
+	if m != nil {
+		return m.TreasuryFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityProposals() []CommunityProposal {
+	if m != nil {
+		return m.CommunityProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityVotes() []CommunityVote {
+	if m != nil {
+		return m.CommunityVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNFTApprovals() []NFTApproval {
+	if m != nil {
+		return m.NFTApprovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorApprovals() []OperatorApproval {
+	if m != nil {
+		return m.OperatorApprovals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nft.v1beta1.GenesisState")
 }
@@ -91,25 +259,61 @@ func init() {
 func init() { proto.RegisterFile("nft/v1beta1/genesis.proto", fileDescriptor_52737c725dd1928d) }
 
 var fileDescriptor_52737c725dd1928d = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbd, 0x4a, 0xc4, 0x40,
-	0x14, 0x85, 0x13, 0x57, 0xb6, 0x48, 0xac, 0x82, 0x3f, 0x71, 0x85, 0x51, 0xc4, 0xc2, 0x2a, 0x61,
-	0x15, 0x2c, 0x57, 0x5c, 0x0b, 0x2b, 0x45, 0xb4, 0xb3, 0x91, 0x24, 0xde, 0x8d, 0x61, 0x93, 0xb9,
-	0x21, 0x73, 0xa3, 0xe4, 0x2d, 0x7c, 0x2b, 0xb7, 0xdc, 0xd2, 0x4a, 0x24, 0x79, 0x11, 0xc9, 0xcc,
-	0xb0, 0x8e, 0xdb, 0x0d, 0xf7, 0x9c, 0x8f, 0xf3, 0x31, 0xce, 0x3e, 0x9f, 0x51, 0xf8, 0x36, 0x8e,
-	0x81, 0xa2, 0x71, 0x98, 0x02, 0x07, 0x91, 0x89, 0xa0, 0xac, 0x90, 0xd0, 0x73, 0xf9, 0x8c, 0x02,
-	0x1d, 0x8d, 0xb6, 0x53, 0x4c, 0x51, 0xde, 0xc3, 0xfe, 0xa5, 0x2a, 0xa3, 0x1d, 0x93, 0xee, 0xeb,
-	0xea, 0xcc, 0xcc, 0x73, 0x11, 0x55, 0x73, 0xa0, 0xe7, 0x32, 0x8f, 0x12, 0xd0, 0xf9, 0x81, 0x99,
-	0x27, 0x58, 0x14, 0x35, 0xcf, 0xa8, 0x51, 0xe1, 0xf1, 0xa7, 0xed, 0x6c, 0xdd, 0x28, 0x91, 0x47,
-	0x8a, 0x08, 0xbc, 0x4b, 0xc7, 0x4d, 0x30, 0xcf, 0x21, 0xa1, 0x0c, 0xb9, 0xf0, 0xed, 0xa3, 0xc1,
-	0xa9, 0x7b, 0xb6, 0x17, 0x18, 0x76, 0xc1, 0xf5, 0x2a, 0x9f, 0x6e, 0x2e, 0xbe, 0x0f, 0xad, 0x07,
-	0x93, 0xf0, 0x2e, 0x9c, 0x21, 0x56, 0x2f, 0x50, 0x09, 0x7f, 0x43, 0xb2, 0xfe, 0x3f, 0xf6, 0x56,
-	0xfa, 0xdd, 0xf7, 0x7a, 0x1a, 0xd6, 0x6d, 0x6f, 0xd2, 0x0f, 0x2b, 0xb9, 0x0c, 0x84, 0x3f, 0x90,
-	0xf0, 0xee, 0xda, 0xb0, 0x96, 0xff, 0xdb, 0x5d, 0x01, 0xd3, 0xc9, 0xa2, 0x65, 0xf6, 0xb2, 0x65,
-	0xf6, 0x4f, 0xcb, 0xec, 0x8f, 0x8e, 0x59, 0xcb, 0x8e, 0x59, 0x5f, 0x1d, 0xb3, 0x9e, 0x4e, 0xd2,
-	0x8c, 0x5e, 0xeb, 0x38, 0x48, 0xb0, 0x08, 0xaf, 0x6a, 0x42, 0x8e, 0x45, 0x73, 0x07, 0xf4, 0x8e,
-	0xd5, 0xbc, 0xff, 0xc6, 0x90, 0x9a, 0x12, 0x44, 0x3c, 0x94, 0x1f, 0x72, 0xfe, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xd6, 0xda, 0xe9, 0x15, 0xa4, 0x01, 0x00, 0x00,
+	// 852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcb, 0x6e, 0x1b, 0x37,
+	0x14, 0xb5, 0xea, 0x54, 0x49, 0xa9, 0x87, 0x63, 0xda, 0x49, 0x18, 0x25, 0x55, 0x8c, 0xb6, 0x8b,
+	0xac, 0xa4, 0x3a, 0x01, 0xb2, 0x6b, 0x0a, 0x39, 0x85, 0x8d, 0x14, 0x75, 0x2c, 0x28, 0x6e, 0x0a,
+	0x74, 0x33, 0xa0, 0x67, 0xae, 0xa4, 0x89, 0x67, 0xc8, 0x29, 0x2f, 0x47, 0x82, 0xff, 0xa2, 0xbf,
+	0xd2, 0xbf, 0xc8, 0x32, 0xcb, 0xae, 0x82, 0xc2, 0xfe, 0x91, 0x82, 0x1c, 0xce, 0x4b, 0x99, 0x76,
+	0x27, 0x9d, 0x7b, 0xce, 0xe1, 0xe1, 0xe5, 0x25, 0x87, 0x3c, 0x14, 0x73, 0x3d, 0x5e, 0x1d, 0x5e,
+	0x80, 0xe6, 0x87, 0xe3, 0x05, 0x08, 0xc0, 0x10, 0x47, 0x89, 0x92, 0x5a, 0xd2, 0x8e, 0x98, 0xeb,
+	0x91, 0x2b, 0x0d, 0xf6, 0x17, 0x72, 0x21, 0x2d, 0x3e, 0x36, 0xbf, 0x32, 0xca, 0xe0, 0x5e, 0x55,
+	0x6d, 0xe8, 0x19, 0x3c, 0xac, 0xc2, 0x31, 0x57, 0x97, 0xa0, 0xbd, 0x24, 0xe2, 0x3e, 0xb8, 0xfa,
+	0xa3, 0x6a, 0xdd, 0x97, 0x71, 0x9c, 0x8a, 0x50, 0x5f, 0xb9, 0x22, 0xab, 0x16, 0x13, 0xae, 0x78,
+	0xec, 0x02, 0x0d, 0x1e, 0xd7, 0x6c, 0x43, 0xa1, 0xbd, 0x64, 0xc9, 0x31, 0x37, 0xad, 0xed, 0x64,
+	0x25, 0x53, 0x7f, 0x09, 0xaa, 0xc9, 0xd2, 0x08, 0x8b, 0xca, 0xa0, 0xb6, 0x98, 0x92, 0x89, 0x44,
+	0x1e, 0x65, 0xb5, 0x6f, 0xfe, 0xea, 0x91, 0xee, 0x49, 0xd6, 0x91, 0xb7, 0x9a, 0x6b, 0xa0, 0x3f,
+	0x92, 0x8e, 0x2f, 0xa3, 0x08, 0x7c, 0x1d, 0x4a, 0x81, 0xac, 0x75, 0xb0, 0xfd, 0xb4, 0xf3, 0xec,
+	0xc1, 0xa8, 0xd2, 0xa6, 0xd1, 0xab, 0xa2, 0x7e, 0x74, 0xeb, 0xc3, 0xa7, 0x27, 0x5b, 0xb3, 0xaa,
+	0x82, 0xbe, 0x20, 0x6d, 0xa9, 0x02, 0x50, 0xc8, 0xbe, 0xb0, 0x5a, 0x56, 0xd3, 0x9e, 0xda, 0x46,
+	0x4d, 0x4d, 0x9f, 0x9c, 0xd8, 0xb1, 0xe9, 0x4b, 0xd2, 0xc9, 0xbb, 0x14, 0x02, 0xb2, 0x6d, 0x2b,
+	0xbe, 0xbf, 0xb1, 0xb0, 0xeb, 0x62, 0xb9, 0x6e, 0x21, 0xa0, 0x2f, 0xc8, 0x1d, 0x9e, 0xba, 0xd4,
+	0xb7, 0xac, 0x78, 0xbf, 0x26, 0x9e, 0xa4, 0xd5, 0xc8, 0x05, 0x97, 0x1e, 0x93, 0x7e, 0x90, 0x6a,
+	0x7f, 0xe9, 0x15, 0xea, 0x2f, 0xad, 0xfa, 0x61, 0x4d, 0xfd, 0x93, 0xa1, 0xd4, 0x2d, 0x7a, 0x41,
+	0x05, 0x43, 0xfa, 0x3d, 0x69, 0xcb, 0xf9, 0xdc, 0xec, 0xbb, 0x6d, 0xf5, 0xb4, 0xa6, 0x3f, 0x33,
+	0xa5, 0x62, 0xc7, 0x96, 0x47, 0xcf, 0xc8, 0x6e, 0xd9, 0x38, 0xcf, 0x89, 0x6f, 0x5b, 0xf1, 0xe3,
+	0xff, 0x68, 0x78, 0xd5, 0xe6, 0xae, 0x5f, 0x87, 0x91, 0x1e, 0x92, 0x76, 0x36, 0x4b, 0xec, 0xce,
+	0x41, 0xeb, 0x69, 0xe7, 0xd9, 0x5e, 0xcd, 0x65, 0x6a, 0x4b, 0x79, 0x86, 0x8c, 0x48, 0x4f, 0x48,
+	0xdf, 0xd9, 0x40, 0xe0, 0xcd, 0x01, 0x90, 0x7d, 0x65, 0x03, 0x0c, 0x9a, 0x02, 0x40, 0x70, 0x0c,
+	0x90, 0x3b, 0xf4, 0xfc, 0x2a, 0x48, 0x7f, 0x20, 0x9d, 0x72, 0x5a, 0x91, 0x91, 0x86, 0xe3, 0x3b,
+	0x0d, 0x85, 0x9e, 0x9a, 0xb2, 0x73, 0x20, 0x71, 0x0e, 0x20, 0x9d, 0x10, 0xc2, 0xa3, 0x48, 0xae,
+	0xa3, 0x10, 0x35, 0xb2, 0x8e, 0x55, 0x3f, 0xaa, 0xc7, 0x37, 0xc4, 0x49, 0xce, 0xc9, 0x2d, 0x4a,
+	0x11, 0x9d, 0x90, 0xee, 0x9a, 0x47, 0x11, 0x68, 0xcf, 0xf8, 0x22, 0xeb, 0x36, 0x8c, 0xdf, 0x6f,
+	0x96, 0x60, 0x82, 0xe4, 0xdb, 0xe8, 0xac, 0x4b, 0xc8, 0x9c, 0x88, 0x82, 0x00, 0x20, 0x86, 0xc0,
+	0x73, 0xb7, 0x0b, 0x59, 0xaf, 0xe1, 0x44, 0x66, 0x8e, 0xf5, 0x2e, 0x23, 0xe5, 0x27, 0xa2, 0xea,
+	0x30, 0xd2, 0x29, 0xa1, 0x91, 0xf4, 0x2f, 0x21, 0xf0, 0x16, 0x5c, 0x83, 0xa7, 0xe5, 0x25, 0x08,
+	0x64, 0xfd, 0x06, 0xc7, 0x5f, 0x2c, 0xed, 0x84, 0x6b, 0x38, 0x37, 0xa4, 0xdc, 0x31, 0xaa, 0xc3,
+	0x48, 0x9f, 0x93, 0xdb, 0xd9, 0xe5, 0x46, 0xb6, 0x73, 0xb0, 0xfd, 0xd9, 0x21, 0x9f, 0xda, 0x9a,
+	0x53, 0xe7, 0x4c, 0x3a, 0x23, 0x3b, 0x01, 0x08, 0x19, 0x7b, 0x5a, 0x71, 0x81, 0x76, 0xce, 0xee,
+	0x5a, 0xf1, 0xb7, 0xf5, 0x21, 0x37, 0x9c, 0xb3, 0xb5, 0x00, 0x85, 0xcb, 0x30, 0x39, 0x77, 0x5c,
+	0x67, 0xd6, 0xb7, 0x0e, 0x39, 0xe8, 0xa6, 0xd7, 0xdd, 0x47, 0x2f, 0x86, 0xf8, 0xc2, 0xb8, 0xee,
+	0x36, 0x4e, 0xaf, 0x63, 0x9d, 0x5a, 0x52, 0x39, 0xbd, 0x35, 0x18, 0xe9, 0x2b, 0xd2, 0x7b, 0x2f,
+	0x43, 0xe1, 0x29, 0xf8, 0x23, 0x05, 0x33, 0x05, 0xb4, 0xe1, 0x00, 0x7f, 0x96, 0xa1, 0x98, 0x65,
+	0x04, 0x67, 0xd4, 0x7d, 0x5f, 0x42, 0x1b, 0xa9, 0x42, 0xb1, 0x0a, 0x35, 0x20, 0xdb, 0xfb, 0xbf,
+	0x54, 0xaf, 0x2d, 0xe9, 0xb3, 0x54, 0x19, 0x6c, 0x9f, 0x07, 0xad, 0x80, 0x63, 0xaa, 0xae, 0xbc,
+	0x79, 0x24, 0xd7, 0xc8, 0xf6, 0x1b, 0x9e, 0x87, 0x73, 0x47, 0x39, 0x8e, 0xe4, 0x3a, 0xbf, 0x1f,
+	0xba, 0x82, 0x21, 0xfd, 0x95, 0xec, 0x95, 0xc1, 0xf2, 0x47, 0x18, 0xd9, 0x3d, 0x6b, 0x36, 0x6c,
+	0x8e, 0x36, 0x75, 0x34, 0xe7, 0x48, 0xfd, 0xcd, 0x02, 0xd2, 0xd7, 0x64, 0xa7, 0xb4, 0x5d, 0x49,
+	0xb3, 0xdb, 0xfb, 0x8d, 0x17, 0xd8, 0x71, 0xde, 0xc9, 0x62, 0xaf, 0x7d, 0xbf, 0x0a, 0x22, 0x7d,
+	0x4b, 0x7a, 0x62, 0xae, 0x3d, 0x9e, 0x24, 0x4a, 0xae, 0x4c, 0xb6, 0x07, 0x0d, 0xfd, 0x7f, 0x73,
+	0x7c, 0x3e, 0x71, 0x84, 0xa3, 0x7d, 0x63, 0x73, 0xfd, 0xe9, 0x49, 0xb7, 0x02, 0xe2, 0xac, 0x2b,
+	0xe6, 0xba, 0xf8, 0x47, 0x67, 0x84, 0xca, 0x04, 0x14, 0xd7, 0x52, 0x55, 0x9c, 0x99, 0x75, 0xfe,
+	0xba, 0xfe, 0x42, 0x3a, 0x5a, 0x61, 0x9f, 0xa5, 0xdc, 0x95, 0x1b, 0x38, 0x1e, 0xbd, 0xfc, 0x70,
+	0x3d, 0x6c, 0x7d, 0xbc, 0x1e, 0xb6, 0xfe, 0xb9, 0x1e, 0xb6, 0xfe, 0xbc, 0x19, 0x6e, 0x7d, 0xbc,
+	0x19, 0x6e, 0xfd, 0x7d, 0x33, 0xdc, 0xfa, 0xfd, 0xbb, 0x45, 0xa8, 0x97, 0xe9, 0xc5, 0xc8, 0x97,
+	0xf1, 0x78, 0x92, 0x6a, 0x29, 0x64, 0x7c, 0xf5, 0x06, 0xf4, 0x5a, 0xaa, 0x4b, 0xf3, 0xe5, 0x1e,
+	0xeb, 0xab, 0x04, 0xf0, 0xa2, 0x6d, 0x3f, 0x7d, 0xcf, 0xff, 0x1d, 0x00, 0x0c, 0x7f, 0x9e, 0x7f,
+	0x17, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,127 +336,1202 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Communities) > 0 {
-		for iNdEx := len(m.Communities) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OperatorApprovals) > 0 {
+		for iNdEx := len(m.OperatorApprovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Communities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OperatorApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.NFTApprovals) > 0 {
+		for iNdEx := len(m.NFTApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.CommunityVotes) > 0 {
+		for iNdEx := len(m.CommunityVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CommunityProposals) > 0 {
+		for iNdEx := len(m.CommunityProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.TreasuryFlows) > 0 {
+		for iNdEx := len(m.TreasuryFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CommunityInvites) > 0 {
+		for iNdEx := len(m.CommunityInvites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityInvites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.JoinRequests) > 0 {
+		for iNdEx := len(m.JoinRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JoinRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.CommunityMembers) > 0 {
+		for iNdEx := len(m.CommunityMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DenomTransfers) > 0 {
+		for iNdEx := len(m.DenomTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LockedGateTokens) > 0 {
+		for iNdEx := len(m.LockedGateTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedGateTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RedeemedVouchers) > 0 {
+		for iNdEx := len(m.RedeemedVouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemedVouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.WalletMints) > 0 {
+		for iNdEx := len(m.WalletMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Allowlists) > 0 {
+		for iNdEx := len(m.Allowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MintPhases) > 0 {
+		for iNdEx := len(m.MintPhases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintPhases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CollectionOffers) > 0 {
+		for iNdEx := len(m.CollectionOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectionOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DutchAuctions) > 0 {
+		for iNdEx := len(m.DutchAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DutchAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Communities) > 0 {
+		for iNdEx := len(m.Communities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Communities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Collections) > 0 {
+		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Communities) > 0 {
+		for _, e := range m.Communities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DutchAuctions) > 0 {
+		for _, e := range m.DutchAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollectionOffers) > 0 {
+		for _, e := range m.CollectionOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintPhases) > 0 {
+		for _, e := range m.MintPhases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowlists) > 0 {
+		for _, e := range m.Allowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WalletMints) > 0 {
+		for _, e := range m.WalletMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedeemedVouchers) > 0 {
+		for _, e := range m.RedeemedVouchers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedGateTokens) > 0 {
+		for _, e := range m.LockedGateTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTransfers) > 0 {
+		for _, e := range m.DenomTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityMembers) > 0 {
+		for _, e := range m.CommunityMembers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JoinRequests) > 0 {
+		for _, e := range m.JoinRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityInvites) > 0 {
+		for _, e := range m.CommunityInvites {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryFlows) > 0 {
+		for _, e := range m.TreasuryFlows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityProposals) > 0 {
+		for _, e := range m.CommunityProposals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityVotes) > 0 {
+		for _, e := range m.CommunityVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NFTApprovals) > 0 {
+		for _, e := range m.NFTApprovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorApprovals) > 0 {
+		for _, e := range m.OperatorApprovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collections = append(m.Collections, Collection{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, MarketPlace{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Communities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Communities = append(m.Communities, Community{})
+			if err := m.Communities[len(m.Communities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DutchAuctions = append(m.DutchAuctions, DutchAuction{})
+			if err := m.DutchAuctions[len(m.DutchAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionOffers = append(m.CollectionOffers, CollectionOffer{})
+			if err := m.CollectionOffers[len(m.CollectionOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, CollectedFees{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPhases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintPhases = append(m.MintPhases, MintPhase{})
+			if err := m.MintPhases[len(m.MintPhases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlists = append(m.Allowlists, PhaseAllowlist{})
+			if err := m.Allowlists[len(m.Allowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletMints = append(m.WalletMints, WalletMints{})
+			if err := m.WalletMints[len(m.WalletMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedVouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemedVouchers = append(m.RedeemedVouchers, RedeemedVoucher{})
+			if err := m.RedeemedVouchers[len(m.RedeemedVouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedGateTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedGateTokens = append(m.LockedGateTokens, LockedGateToken{})
+			if err := m.LockedGateTokens[len(m.LockedGateTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransfers = append(m.DenomTransfers, DenomOwnershipTransfer{})
+			if err := m.DenomTransfers[len(m.DenomTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityMembers = append(m.CommunityMembers, CommunityMember{})
+			if err := m.CommunityMembers[len(m.CommunityMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Collections) > 0 {
-		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinRequests = append(m.JoinRequests, JoinRequest{})
+			if err := m.JoinRequests[len(m.JoinRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityInvites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Collections) > 0 {
-		for _, e := range m.Collections {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Communities) > 0 {
-		for _, e := range m.Communities {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CommunityInvites = append(m.CommunityInvites, CommunityInvite{})
+			if err := m.CommunityInvites[len(m.CommunityInvites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFlows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -279,14 +1558,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collections = append(m.Collections, Collection{})
-			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TreasuryFlows = append(m.TreasuryFlows, TreasuryFlow{})
+			if err := m.TreasuryFlows[len(m.TreasuryFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -313,14 +1592,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, MarketPlace{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityProposals = append(m.CommunityProposals, CommunityProposal{})
+			if err := m.CommunityProposals[len(m.CommunityProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Communities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityVotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -347,8 +1626,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Communities = append(m.Communities, Community{})
-			if err := m.Communities[len(m.Communities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityVotes = append(m.CommunityVotes, CommunityVote{})
+			if err := m.CommunityVotes[len(m.CommunityVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTApprovals = append(m.NFTApprovals, NFTApproval{})
+			if err := m.NFTApprovals[len(m.NFTApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorApprovals = append(m.OperatorApprovals, OperatorApproval{})
+			if err := m.OperatorApprovals[len(m.OperatorApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
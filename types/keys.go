@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"errors"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,6 +20,9 @@ const (
 	
 	// RouterKey is the message route for the NFT module
 	RouterKey = ModuleName
+	
+	// FeePoolName is the module account that collects the marketplace fees
+	FeePoolName = "nft_fee_pool"
 )
 
 const (
@@ -36,7 +40,45 @@ var (
 	PrefixMarketPlace = []byte{0x06} // key for market place
 	
 	PrefixCommunity = []byte{0x07}
-	PrefixMembers   = []byte{0x08}
+	PrefixMembers   = []byte{0x08} // legacy key for the member list of a community, moved to PrefixCommunityMember
+
+	PrefixAuction      = []byte{0x09} // key for open auctions
+	PrefixAuctionQueue = []byte{0x0a} // key for auctions ordered by end time
+	PrefixDutchAuction = []byte{0x0b} // key for open dutch auctions
+	PrefixOffer        = []byte{0x0c} // key for offers on an nft
+	PrefixOfferBidder  = []byte{0x0d} // key for offers made by a bidder
+	PrefixOfferQueue   = []byte{0x0e} // key for offers ordered by expiry
+
+	PrefixCollectionOffer      = []byte{0x0f} // key for collection offers
+	PrefixCollectionOfferPrice = []byte{0x10} // key for collection offers of a denom ordered by price
+	PrefixCollectionOfferQueue = []byte{0x11} // key for collection offers ordered by expiry
+	KeyNextCollectionOfferID   = []byte{0x12} // key for the next collection offer id
+
+	PrefixListingQueue     = []byte{0x13} // key for market place orders ordered by expiry
+	PrefixFilledOrderQueue = []byte{0x14} // key for filled market place orders ordered by fill time
+	PrefixCollectedFees    = []byte{0x15} // key for the marketplace fees collected by the fee pool and communities
+	PrefixMintPhase        = []byte{0x16} // key for the mint phases of primary sale denoms
+	PrefixAllowlist        = []byte{0x17} // key for the allowlisted addresses of mint phases
+	PrefixWalletMints      = []byte{0x18} // key for the number of nfts minted by a wallet in a mint phase
+	PrefixVoucherNonce     = []byte{0x19} // key for the nonces of redeemed mint vouchers
+	PrefixGateLock         = []byte{0x1a} // key for the nfts locked by minting in a token gated denom
+	PrefixMinter           = []byte{0x1b} // key for the addresses allowed to mint into a denom
+	PrefixDenomTransfer    = []byte{0x1c} // key for the pending ownership transfers of denoms
+	PrefixDenomCreator     = []byte{0x1d} // key for the denoms owned by a creator
+	PrefixCommunityMember  = []byte{0x1e} // key for the members of communities with their roles
+	PrefixJoinRequest      = []byte{0x1f} // key for the pending requests to join communities
+	PrefixCommunityInvite  = []byte{0x20} // key for the invite codes of communities
+	PrefixMemberCommunity  = []byte{0x21} // key for the communities of a member
+	PrefixTreasuryFlow     = []byte{0x22} // key for the funds that went in and out of community treasuries
+	KeyNextProposalID      = []byte{0x23} // key for the next community proposal id
+	PrefixProposal         = []byte{0x24} // key for community proposals
+	PrefixProposalIndex    = []byte{0x25} // key for the proposals of a community
+	PrefixProposalQueue    = []byte{0x26} // key for community proposals ordered by voting end time
+	PrefixProposalVote     = []byte{0x27} // key for the votes on community proposals
+	PrefixNFTApproval      = []byte{0x28} // key for the spenders approved for single nfts
+	PrefixOperator         = []byte{0x29} // key for the operators approved for the nfts of an owner
+	PrefixCommunityDenom   = []byte{0x2a} // key for the denoms of a community
+	PrefixProposalNFTVote  = []byte{0x2b} // key for the nfts that voted on community proposals
 	
 	delimiter = []byte("/")
 )
@@ -125,6 +167,456 @@ func KeyMarketPlaceNFT(denomID, tokenID string) []byte {
 	return key
 }
 
+func KeyAuction(denomID, tokenID string) []byte {
+	key := append(PrefixAuction, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+func KeyDutchAuction(denomID, tokenID string) []byte {
+	key := append(PrefixDutchAuction, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// KeyAuctionQueue gets the key of an auction ordered by its end time
+func KeyAuctionQueue(endTime time.Time, denomID, tokenID string) []byte {
+	key := append(PrefixAuctionQueue, delimiter...)
+	key = append(key, sdk.FormatTimeBytes(endTime)...)
+	key = append(key, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// SplitKeyAuctionQueue return the end time, denom and id from the key of a queued auction
+func SplitKeyAuctionQueue(key []byte) (endTime time.Time, denomID, tokenID string, err error) {
+	key = key[len(PrefixAuctionQueue)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 3 {
+		return endTime, denomID, tokenID, errors.New("wrong KeyAuctionQueue")
+	}
+
+	endTime, err = sdk.ParseTimeBytes(keys[0])
+	denomID = string(keys[1])
+	tokenID = string(keys[2])
+	return
+}
+
+// KeyOffer gets the key of an offer on an nft, or the prefix of all the offers on it when bidder is empty
+func KeyOffer(denomID, tokenID, bidder string) []byte {
+	key := append(PrefixOffer, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 && len(bidder) > 0 {
+		key = append(key, []byte(bidder)...)
+	}
+	return key
+}
+
+// KeyOfferBidder gets the key of an offer indexed by its bidder
+func KeyOfferBidder(bidder sdk.AccAddress, denomID, tokenID string) []byte {
+	key := append(PrefixOfferBidder, delimiter...)
+	if bidder != nil {
+		key = append(key, []byte(bidder.String())...)
+		key = append(key, delimiter...)
+	}
+
+	if bidder != nil && len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if bidder != nil && len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// SplitKeyOfferBidder return the bidder, denom and id from the key of an offer indexed by bidder
+func SplitKeyOfferBidder(key []byte) (bidder sdk.AccAddress, denomID, tokenID string, err error) {
+	key = key[len(PrefixOfferBidder)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 3 {
+		return bidder, denomID, tokenID, errors.New("wrong KeyOfferBidder")
+	}
+
+	bidder, _ = sdk.AccAddressFromBech32(string(keys[0]))
+	denomID = string(keys[1])
+	tokenID = string(keys[2])
+	return
+}
+
+// KeyOfferQueue gets the key of an offer ordered by its expiry
+func KeyOfferQueue(expiresAt time.Time, denomID, tokenID, bidder string) []byte {
+	key := append(PrefixOfferQueue, delimiter...)
+	key = append(key, sdk.FormatTimeBytes(expiresAt)...)
+	key = append(key, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 && len(bidder) > 0 {
+		key = append(key, []byte(bidder)...)
+	}
+	return key
+}
+
+// SplitKeyOfferQueue return the expiry, denom, id and bidder from the key of a queued offer
+func SplitKeyOfferQueue(key []byte) (expiresAt time.Time, denomID, tokenID, bidder string, err error) {
+	key = key[len(PrefixOfferQueue)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 4 {
+		return expiresAt, denomID, tokenID, bidder, errors.New("wrong KeyOfferQueue")
+	}
+
+	expiresAt, err = sdk.ParseTimeBytes(keys[0])
+	denomID = string(keys[1])
+	tokenID = string(keys[2])
+	bidder = string(keys[3])
+	return
+}
+
+// KeyCollectionOffer gets the key of a collection offer
+func KeyCollectionOffer(id uint64) []byte {
+	key := append(PrefixCollectionOffer, delimiter...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyCollectionOfferPrice gets the key of a collection offer ordered by its price. The amount is
+// encoded as a fixed width big endian integer so that byte order matches numeric order.
+// An empty priceDenom returns the prefix of all the collection offers of a denom.
+func KeyCollectionOfferPrice(denomID, priceDenom string, amount sdk.Int, id uint64) []byte {
+	key := append(PrefixCollectionOfferPrice, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	if len(priceDenom) > 0 {
+		key = append(key, []byte(priceDenom)...)
+		key = append(key, delimiter...)
+		key = append(key, amount.BigInt().FillBytes(make([]byte, 32))...)
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyCollectionOfferQueue gets the key of a collection offer ordered by its expiry
+func KeyCollectionOfferQueue(expiresAt time.Time, id uint64) []byte {
+	key := append(PrefixCollectionOfferQueue, delimiter...)
+	key = append(key, sdk.FormatTimeBytes(expiresAt)...)
+	key = append(key, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyListingQueue gets the key of a market place order ordered by its expiry
+func KeyListingQueue(expiresAt time.Time, denomID, tokenID string) []byte {
+	return keyOrderQueue(PrefixListingQueue, expiresAt, denomID, tokenID)
+}
+
+// KeyFilledOrderQueue gets the key of a filled market place order ordered by its fill time
+func KeyFilledOrderQueue(filledAt time.Time, denomID, tokenID string) []byte {
+	return keyOrderQueue(PrefixFilledOrderQueue, filledAt, denomID, tokenID)
+}
+
+func keyOrderQueue(prefix []byte, t time.Time, denomID, tokenID string) []byte {
+	key := append(prefix, delimiter...)
+	key = append(key, sdk.FormatTimeBytes(t)...)
+	key = append(key, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// SplitKeyOrderQueue return the time, denom and id from the key of a queued market place order
+func SplitKeyOrderQueue(key []byte) (t time.Time, denomID, tokenID string, err error) {
+	key = key[len(PrefixListingQueue)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 3 {
+		return t, denomID, tokenID, errors.New("wrong KeyOrderQueue")
+	}
+
+	t, err = sdk.ParseTimeBytes(keys[0])
+	denomID = string(keys[1])
+	tokenID = string(keys[2])
+	return
+}
+
+// KeyCollectedFees gets the key of the fees collected by a community, or by the fee pool when communityID is empty
+func KeyCollectedFees(communityID string) []byte {
+	key := append(PrefixCollectedFees, delimiter...)
+	return append(key, []byte(communityID)...)
+}
+
+// KeyTreasuryFlow gets the key of the treasury flow of a community
+func KeyTreasuryFlow(communityID string) []byte {
+	key := append(PrefixTreasuryFlow, delimiter...)
+	return append(key, []byte(communityID)...)
+}
+
+// KeyProposal gets the key of a community proposal
+func KeyProposal(id uint64) []byte {
+	key := append(PrefixProposal, delimiter...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyCommunityProposal gets the key of a proposal of a community, a zero id returns the key of every proposal of the community
+func KeyCommunityProposal(communityID string, id uint64) []byte {
+	key := append(PrefixProposalIndex, delimiter...)
+	key = append(key, []byte(communityID)...)
+	key = append(key, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyProposalQueue gets the key of a community proposal ordered by its voting end time
+func KeyProposalQueue(endTime time.Time, id uint64) []byte {
+	key := append(PrefixProposalQueue, delimiter...)
+	key = append(key, sdk.FormatTimeBytes(endTime)...)
+	key = append(key, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyProposalVote gets the key of a vote on a community proposal, a nil voter returns the key of every vote on the proposal
+func KeyProposalVote(id uint64, voter sdk.AccAddress) []byte {
+	key := append(PrefixProposalVote, delimiter...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, delimiter...)
+	return append(key, voter.Bytes()...)
+}
+
+// KeyProposalNFTVote gets the key of an nft that voted on a community proposal
+func KeyProposalNFTVote(id uint64, denomID, tokenID string) []byte {
+	key := append(PrefixProposalNFTVote, delimiter...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(tokenID)...)
+}
+
+// KeyNFTApproval gets the key of the spender approved for an nft
+func KeyNFTApproval(denomID, tokenID string) []byte {
+	key := append(PrefixNFTApproval, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(tokenID)...)
+}
+
+// KeyOperator gets the key of an operator approved for the nfts of the owner in a denom, an empty denomID
+// is the key of an operator of every denom and a nil operator returns the key of every operator of the owner
+func KeyOperator(owner, operator sdk.AccAddress, denomID string) []byte {
+	key := append(PrefixOperator, delimiter...)
+	key = append(key, owner.Bytes()...)
+	key = append(key, delimiter...)
+	if operator == nil {
+		return key
+	}
+
+	key = append(key, operator.Bytes()...)
+	key = append(key, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyMintPhase gets the key of a mint phase. A zero phaseID returns the prefix of all the phases of the denom.
+func KeyMintPhase(denomID string, phaseID uint64) []byte {
+	key := append(PrefixMintPhase, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	if phaseID > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(phaseID)...)
+	}
+	return key
+}
+
+// KeyAllowlist gets the key of an allowlisted address of a mint phase
+func KeyAllowlist(denomID string, phaseID uint64, address sdk.AccAddress) []byte {
+	return keyPhaseAddress(PrefixAllowlist, denomID, phaseID, address)
+}
+
+// KeyWalletMints gets the key of the number of nfts minted by a wallet in a mint phase
+func KeyWalletMints(denomID string, phaseID uint64, address sdk.AccAddress) []byte {
+	return keyPhaseAddress(PrefixWalletMints, denomID, phaseID, address)
+}
+
+func keyPhaseAddress(prefix []byte, denomID string, phaseID uint64, address sdk.AccAddress) []byte {
+	key := append(prefix, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	if phaseID > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(phaseID)...)
+		key = append(key, delimiter...)
+		key = append(key, address.Bytes()...)
+	}
+	return key
+}
+
+// SplitKeyPhaseAddress return the denom, phase and address from the key of an allowlisted address or wallet mints
+func SplitKeyPhaseAddress(key []byte) (denomID string, phaseID uint64, address sdk.AccAddress, err error) {
+	key = key[len(PrefixAllowlist)+len(delimiter):]
+	i := bytes.Index(key, delimiter)
+	if i < 0 || len(key) < i+len(delimiter)+8+len(delimiter) {
+		return denomID, phaseID, address, errors.New("wrong KeyPhaseAddress")
+	}
+
+	denomID = string(key[:i])
+	key = key[i+len(delimiter):]
+	phaseID = sdk.BigEndianToUint64(key[:8])
+	address = sdk.AccAddress(key[8+len(delimiter):])
+	return
+}
+
+// KeyVoucherNonce gets the key of a redeemed mint voucher nonce of a denom
+func KeyVoucherNonce(denomID string, nonce uint64) []byte {
+	key := append(PrefixVoucherNonce, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// SplitKeyVoucherNonce return the denom and nonce from the key of a redeemed mint voucher
+func SplitKeyVoucherNonce(key []byte) (denomID string, nonce uint64, err error) {
+	key = key[len(PrefixVoucherNonce)+len(delimiter):]
+	if len(key) < 8+len(delimiter) {
+		return denomID, nonce, errors.New("wrong KeyVoucherNonce")
+	}
+
+	denomID = string(key[:len(key)-8-len(delimiter)])
+	nonce = sdk.BigEndianToUint64(key[len(key)-8:])
+	return
+}
+
+// KeyGateLock gets the key of an nft of a dependent denom locked for a token gated denom
+func KeyGateLock(denomID, dependentDenomID, tokenID string) []byte {
+	key := append(PrefixGateLock, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	if len(dependentDenomID) > 0 {
+		key = append(key, []byte(dependentDenomID)...)
+		key = append(key, delimiter...)
+	}
+	return append(key, []byte(tokenID)...)
+}
+
+// SplitKeyGateLock return the denom, dependent denom and nft id from the key of a locked nft
+func SplitKeyGateLock(key []byte) (denomID, dependentDenomID, tokenID string, err error) {
+	key = key[len(PrefixGateLock)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 3 {
+		return denomID, dependentDenomID, tokenID, errors.New("wrong KeyGateLock")
+	}
+
+	return string(keys[0]), string(keys[1]), string(keys[2]), nil
+}
+
+// KeyMinter gets the key of a minter of a denom, a nil address returns the key of every minter of the denom
+func KeyMinter(denomID string, address sdk.AccAddress) []byte {
+	key := append(PrefixMinter, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	return append(key, address.Bytes()...)
+}
+
+// KeyDenomTransfer gets the key of the pending ownership transfer of a denom
+func KeyDenomTransfer(denomID string) []byte {
+	key := append(PrefixDenomTransfer, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyDenomCreator gets the key of a denom owned by a creator, an empty denomID returns the key of every denom of the creator
+func KeyDenomCreator(creator, denomID string) []byte {
+	key := append(PrefixDenomCreator, delimiter...)
+	key = append(key, []byte(creator)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyCommunityDenom gets the key of a denom of a community, an empty denomID returns the key of every denom of the community
+func KeyCommunityDenom(communityID, denomID string) []byte {
+	key := append(PrefixCommunityDenom, delimiter...)
+	key = append(key, []byte(communityID)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyCommunityMember gets the key of a community member, a nil address returns the key of every member of the community
+func KeyCommunityMember(communityID string, address sdk.AccAddress) []byte {
+	key := append(PrefixCommunityMember, delimiter...)
+	key = append(key, []byte(communityID)...)
+	key = append(key, delimiter...)
+	return append(key, address.Bytes()...)
+}
+
+// KeyMemberCommunity gets the key of a community joined by a member, an empty communityID returns the key of every community of the member
+func KeyMemberCommunity(address, communityID string) []byte {
+	key := append(PrefixMemberCommunity, delimiter...)
+	key = append(key, []byte(address)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(communityID)...)
+}
+
+// KeyJoinRequest gets the key of a pending join request, a nil address returns the key of every request of the community
+func KeyJoinRequest(communityID string, address sdk.AccAddress) []byte {
+	key := append(PrefixJoinRequest, delimiter...)
+	key = append(key, []byte(communityID)...)
+	key = append(key, delimiter...)
+	return append(key, address.Bytes()...)
+}
+
+// KeyCommunityInvite gets the key of an invite code of a community by its hash
+func KeyCommunityInvite(communityID string, codeHash []byte) []byte {
+	key := append(PrefixCommunityInvite, delimiter...)
+	key = append(key, []byte(communityID)...)
+	key = append(key, delimiter...)
+	return append(key, codeHash...)
+}
+
 func KeyCommunityID(id string) []byte {
 	key := append(PrefixCommunity, delimiter...)
 	return append(key, []byte(id)...)
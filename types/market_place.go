@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/AutonomyNetwork/nft/exported"
 )
@@ -54,3 +57,177 @@ func (m MarketPlace) GetCurrency() string {
 func (m MarketPlace) GetFiatAmount() string {
 	return m.FiatAmount
 }
+
+func (m MarketPlace) GetListedType() int32 {
+	return int32(m.ListedType)
+}
+
+// IsExpired returns whether the order has an expiry at or before the given time
+func (m MarketPlace) IsExpired(blockTime time.Time) bool {
+	return m.ExpiresAt != nil && !m.ExpiresAt.After(blockTime)
+}
+
+// ----------------------------------------------------------------------------
+// Auction
+
+func NewAuction(id, denomID, startPrice string, seller sdk.AccAddress, startTime, endTime time.Time) Auction {
+	return Auction{
+		NftId:      id,
+		DenomId:    denomID,
+		Seller:     seller.String(),
+		StartPrice: startPrice,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	}
+}
+
+func (a Auction) GetSeller() sdk.AccAddress {
+	seller, _ := sdk.AccAddressFromBech32(a.Seller)
+	return seller
+}
+
+func (a Auction) GetHighestBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(a.HighestBidder)
+	return bidder
+}
+
+// HasBid returns whether any bid has been placed on the auction
+func (a Auction) HasBid() bool {
+	return len(a.HighestBid) > 0
+}
+
+// ----------------------------------------------------------------------------
+// DutchAuction
+
+func NewDutchAuction(id, denomID, startPrice, floorPrice, decayAmount string, decayInterval time.Duration, seller sdk.AccAddress, startTime time.Time) DutchAuction {
+	return DutchAuction{
+		NftId:         id,
+		DenomId:       denomID,
+		Seller:        seller.String(),
+		StartPrice:    startPrice,
+		FloorPrice:    floorPrice,
+		DecayAmount:   decayAmount,
+		DecayInterval: decayInterval,
+		StartTime:     startTime,
+	}
+}
+
+func (a DutchAuction) GetSeller() sdk.AccAddress {
+	seller, _ := sdk.AccAddressFromBech32(a.Seller)
+	return seller
+}
+
+// CurrentPrice returns the asking price at the given time. The start price drops by
+// the decay amount once every decay interval and never goes below the floor price.
+func (a DutchAuction) CurrentPrice(blockTime time.Time) (sdk.Coin, error) {
+	startPrice, err := sdk.ParseCoinNormalized(a.StartPrice)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	floorPrice, err := sdk.ParseCoinNormalized(a.FloorPrice)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	decayAmount, err := sdk.ParseCoinNormalized(a.DecayAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if a.DecayInterval <= 0 || !blockTime.After(a.StartTime) {
+		return startPrice, nil
+	}
+
+	steps := sdk.NewInt(int64(blockTime.Sub(a.StartTime) / a.DecayInterval))
+	decay := decayAmount.Amount.Mul(steps)
+	if decay.GTE(startPrice.Amount.Sub(floorPrice.Amount)) {
+		return floorPrice, nil
+	}
+
+	return sdk.NewCoin(startPrice.Denom, startPrice.Amount.Sub(decay)), nil
+}
+
+// ValidateDutchAuctionPrices checks that the start, floor and decay amounts share a denom
+// and describe a descending price.
+func ValidateDutchAuctionPrices(startPrice, floorPrice, decayAmount string) error {
+	start, err := sdk.ParseCoinNormalized(startPrice)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid start price %s", err)
+	}
+
+	floor, err := sdk.ParseCoinNormalized(floorPrice)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid floor price %s", err)
+	}
+
+	decay, err := sdk.ParseCoinNormalized(decayAmount)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid decay amount %s", err)
+	}
+
+	if start.Denom != floor.Denom || start.Denom != decay.Denom {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "start price, floor price and decay amount must use the same denom")
+	}
+
+	if !floor.IsPositive() || !decay.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "floor price and decay amount must be positive")
+	}
+
+	if !floor.IsLT(start) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "start price %s must be greater than floor price %s", start, floor)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Offer
+
+func NewOffer(id, denomID, amount string, expiresAt *time.Time, bidder sdk.AccAddress) Offer {
+	return Offer{
+		NftId:     id,
+		DenomId:   denomID,
+		Bidder:    bidder.String(),
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (o Offer) GetBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(o.Bidder)
+	return bidder
+}
+
+// IsExpired returns whether the offer has an expiry at or before the given time
+func (o Offer) IsExpired(blockTime time.Time) bool {
+	return o.ExpiresAt != nil && !o.ExpiresAt.After(blockTime)
+}
+
+// ----------------------------------------------------------------------------
+// CollectionOffer
+
+func NewCollectionOffer(id uint64, denomID, price string, quantity uint64, expiresAt *time.Time, bidder sdk.AccAddress) CollectionOffer {
+	return CollectionOffer{
+		Id:        id,
+		DenomId:   denomID,
+		Bidder:    bidder.String(),
+		Price:     price,
+		Quantity:  quantity,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (o CollectionOffer) GetBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(o.Bidder)
+	return bidder
+}
+
+// Remaining returns the number of tokens the offer can still buy
+func (o CollectionOffer) Remaining() uint64 {
+	return o.Quantity - o.Filled
+}
+
+// IsExpired returns whether the offer has an expiry at or before the given time
+func (o CollectionOffer) IsExpired(blockTime time.Time) bool {
+	return o.ExpiresAt != nil && !o.ExpiresAt.After(blockTime)
+}
@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,21 +33,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ListedType int32
 
 const (
-	Unspecified ListedType = 0
-	Fiat        ListedType = 1
-	Crypto      ListedType = 2
+	Unspecified       ListedType = 0
+	Fiat              ListedType = 1
+	Crypto            ListedType = 2
+	EnglishAuction    ListedType = 3
+	DescendingAuction ListedType = 4
 )
 
 var ListedType_name = map[int32]string{
 	0: "LISTED_TYPE_UNSPECIFIED",
 	1: "LISTED_TYPE_FIAT",
 	2: "LISTED_TYPE_CRYPTO",
+	3: "LISTED_TYPE_ENGLISH_AUCTION",
+	4: "LISTED_TYPE_DUTCH_AUCTION",
 }
 
 var ListedType_value = map[string]int32{
-	"LISTED_TYPE_UNSPECIFIED": 0,
-	"LISTED_TYPE_FIAT":        1,
-	"LISTED_TYPE_CRYPTO":      2,
+	"LISTED_TYPE_UNSPECIFIED":     0,
+	"LISTED_TYPE_FIAT":            1,
+	"LISTED_TYPE_CRYPTO":          2,
+	"LISTED_TYPE_ENGLISH_AUCTION": 3,
+	"LISTED_TYPE_DUTCH_AUCTION":   4,
 }
 
 func (x ListedType) String() string {
@@ -63,6 +76,8 @@ type MarketPlace struct {
 	FiatAmount string     `protobuf:"bytes,9,opt,name=fiat_amount,json=fiatAmount,proto3" json:"fiat_amount,omitempty"`
 	OrderRefId string     `protobuf:"bytes,10,opt,name=order_ref_id,json=orderRefId,proto3" json:"order_ref_id,omitempty"`
 	Listed     bool       `protobuf:"varint,11,opt,name=listed,proto3" json:"listed,omitempty"`
+	ExpiresAt  *time.Time `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+	FilledAt   *time.Time `protobuf:"bytes,13,opt,name=filled_at,json=filledAt,proto3,stdtime" json:"filled_at,omitempty" yaml:"filled_at"`
 }
 
 func (m *MarketPlace) Reset()         { *m = MarketPlace{} }
@@ -98,46 +113,299 @@ func (m *MarketPlace) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketPlace proto.InternalMessageInfo
 
+type Auction struct {
+	NftId         string    `protobuf:"bytes,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	DenomId       string    `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Seller        string    `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	StartPrice    string    `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	HighestBid    string    `protobuf:"bytes,5,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	HighestBidder string    `protobuf:"bytes,6,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	StartTime     time.Time `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime       time.Time `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b3c3c94d423baa, []int{1}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+type DutchAuction struct {
+	NftId         string        `protobuf:"bytes,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	DenomId       string        `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Seller        string        `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	StartPrice    string        `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	FloorPrice    string        `protobuf:"bytes,5,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	DecayAmount   string        `protobuf:"bytes,6,opt,name=decay_amount,json=decayAmount,proto3" json:"decay_amount,omitempty"`
+	DecayInterval time.Duration `protobuf:"bytes,7,opt,name=decay_interval,json=decayInterval,proto3,stdduration" json:"decay_interval" yaml:"decay_interval"`
+	StartTime     time.Time     `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b3c3c94d423baa, []int{2}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+type Offer struct {
+	NftId     string     `protobuf:"bytes,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	DenomId   string     `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Bidder    string     `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount    string     `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+}
+
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b3c3c94d423baa, []int{3}
+}
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+type CollectionOffer struct {
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DenomId   string     `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Bidder    string     `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Price     string     `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  uint64     `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Filled    uint64     `protobuf:"varint,6,opt,name=filled,proto3" json:"filled,omitempty"`
+	ExpiresAt *time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+}
+
+func (m *CollectionOffer) Reset()         { *m = CollectionOffer{} }
+func (m *CollectionOffer) String() string { return proto.CompactTextString(m) }
+func (*CollectionOffer) ProtoMessage()    {}
+func (*CollectionOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b3c3c94d423baa, []int{4}
+}
+func (m *CollectionOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectionOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionOffer.Merge(m, src)
+}
+func (m *CollectionOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionOffer proto.InternalMessageInfo
+
+// CollectedFees tracks the marketplace fees collected by the fee pool, or by a community
+// treasury when community_id is set.
+type CollectedFees struct {
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	CommunityId string                                   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+}
+
+func (m *CollectedFees) Reset()         { *m = CollectedFees{} }
+func (m *CollectedFees) String() string { return proto.CompactTextString(m) }
+func (*CollectedFees) ProtoMessage()    {}
+func (*CollectedFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b3c3c94d423baa, []int{5}
+}
+func (m *CollectedFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectedFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedFees.Merge(m, src)
+}
+func (m *CollectedFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectedFees proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("nft.v1beta1.ListedType", ListedType_name, ListedType_value)
 	proto.RegisterType((*MarketPlace)(nil), "nft.v1beta1.MarketPlace")
+	proto.RegisterType((*Auction)(nil), "nft.v1beta1.Auction")
+	proto.RegisterType((*DutchAuction)(nil), "nft.v1beta1.DutchAuction")
+	proto.RegisterType((*Offer)(nil), "nft.v1beta1.Offer")
+	proto.RegisterType((*CollectionOffer)(nil), "nft.v1beta1.CollectionOffer")
+	proto.RegisterType((*CollectedFees)(nil), "nft.v1beta1.CollectedFees")
 }
 
 func init() { proto.RegisterFile("nft/v1beta1/market_place.proto", fileDescriptor_79b3c3c94d423baa) }
 
 var fileDescriptor_79b3c3c94d423baa = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x90, 0xa4, 0xe9, 0xba, 0xa2, 0xd1, 0x52, 0xd1, 0x95, 0x0f, 0x1b, 0x2b, 0x42,
-	0x28, 0x42, 0x60, 0xab, 0x70, 0x41, 0xdc, 0xf2, 0x57, 0xb2, 0x54, 0x42, 0xe4, 0xba, 0x87, 0x72,
-	0xb1, 0x1c, 0x7b, 0x1d, 0x56, 0xb5, 0xbd, 0xd6, 0x66, 0x0d, 0xf2, 0x9d, 0x03, 0xca, 0x89, 0x17,
-	0xc8, 0x89, 0x97, 0xe9, 0xb1, 0x47, 0x4e, 0x15, 0x24, 0x4f, 0x40, 0x9f, 0x00, 0x79, 0x1d, 0x4a,
-	0x6e, 0xf3, 0x7d, 0xdf, 0x6f, 0x34, 0xb3, 0xab, 0x01, 0x38, 0x8d, 0x84, 0xf5, 0xf9, 0x6c, 0x4e,
-	0x84, 0x7f, 0x66, 0x25, 0x3e, 0xbf, 0x26, 0xc2, 0xcb, 0x62, 0x3f, 0x20, 0x66, 0xc6, 0x99, 0x60,
-	0x50, 0x4b, 0x23, 0x61, 0xee, 0x72, 0xfd, 0x64, 0xc1, 0x16, 0x4c, 0xfa, 0x56, 0x59, 0x55, 0x48,
-	0xf7, 0x4f, 0x0d, 0x68, 0xef, 0x65, 0xe7, 0xac, 0x6c, 0x84, 0xcf, 0x41, 0x23, 0x8d, 0x84, 0x1d,
-	0x22, 0xd5, 0x50, 0x7b, 0x87, 0x83, 0xf6, 0xfd, 0x5d, 0xe7, 0xa8, 0xf0, 0x93, 0xf8, 0x5d, 0x77,
-	0x3a, 0x71, 0xed, 0x51, 0xd7, 0xa9, 0x62, 0xf8, 0x0a, 0x1c, 0x84, 0x24, 0x65, 0x89, 0x3d, 0x42,
-	0x35, 0x49, 0x3e, 0xb9, 0xbf, 0xeb, 0x1c, 0x57, 0xa4, 0x0c, 0x3c, 0x1a, 0x76, 0x9d, 0x7f, 0x0c,
-	0x3c, 0x01, 0x8d, 0x8c, 0xd3, 0x80, 0xa0, 0x47, 0x25, 0xec, 0x54, 0x02, 0x3e, 0x05, 0xcd, 0x25,
-	0x89, 0x63, 0xc2, 0x51, 0x5d, 0xda, 0x3b, 0x55, 0xd2, 0xf3, 0xbc, 0x20, 0x1c, 0x35, 0x2a, 0x5a,
-	0x8a, 0x92, 0x8e, 0x68, 0x1c, 0x93, 0x10, 0x35, 0x0d, 0xb5, 0xd7, 0x72, 0x76, 0x0a, 0xbe, 0x05,
-	0x5a, 0x4c, 0x97, 0x82, 0x84, 0x9e, 0x28, 0x32, 0x82, 0x0e, 0x0c, 0xb5, 0xf7, 0xf8, 0xf5, 0xa9,
-	0xb9, 0xf7, 0x76, 0xf3, 0x5c, 0xe6, 0x6e, 0x91, 0x11, 0x07, 0xc4, 0x0f, 0x35, 0xd4, 0x41, 0x2b,
-	0xc8, 0x39, 0x27, 0x69, 0x50, 0xa0, 0x96, 0x1c, 0xf5, 0xa0, 0x61, 0x07, 0x68, 0x11, 0xf5, 0x85,
-	0xe7, 0x27, 0x2c, 0x4f, 0x05, 0x3a, 0x94, 0x31, 0x28, 0xad, 0xbe, 0x74, 0xa0, 0x01, 0x8e, 0x18,
-	0x0f, 0x09, 0xf7, 0x38, 0x89, 0x3c, 0x1a, 0x22, 0x50, 0x11, 0xd2, 0x73, 0x48, 0x64, 0x87, 0xe5,
-	0xc2, 0xd5, 0x30, 0xa4, 0x55, 0x0b, 0x57, 0xea, 0xc5, 0x57, 0x15, 0x80, 0xff, 0x1b, 0xc1, 0x97,
-	0xe0, 0xf4, 0xdc, 0xbe, 0x70, 0xc7, 0x23, 0xcf, 0xbd, 0x9a, 0x8d, 0xbd, 0xcb, 0xe9, 0xc5, 0x6c,
-	0x3c, 0xb4, 0x27, 0xf6, 0x78, 0xd4, 0x56, 0xf4, 0xe3, 0xd5, 0xda, 0xd0, 0x2e, 0xd3, 0x65, 0x46,
-	0x02, 0x1a, 0x51, 0x12, 0x42, 0x0c, 0xda, 0xfb, 0xf4, 0xc4, 0xee, 0xbb, 0x6d, 0x55, 0x6f, 0xad,
-	0xd6, 0x46, 0x7d, 0x42, 0x7d, 0x01, 0xbb, 0x00, 0xee, 0xe7, 0x43, 0xe7, 0x6a, 0xe6, 0x7e, 0x68,
-	0xd7, 0x74, 0xb0, 0x5a, 0x1b, 0xcd, 0x21, 0x2f, 0x32, 0xc1, 0xf4, 0xfa, 0xb7, 0x1f, 0x58, 0x19,
-	0x0c, 0x6e, 0x7e, 0x63, 0xe5, 0x66, 0x83, 0xd5, 0xdb, 0x0d, 0x56, 0x7f, 0x6d, 0xb0, 0xfa, 0x7d,
-	0x8b, 0x95, 0xdb, 0x2d, 0x56, 0x7e, 0x6e, 0xb1, 0xf2, 0xf1, 0xd9, 0x82, 0x8a, 0x4f, 0xf9, 0xdc,
-	0x0c, 0x58, 0x62, 0xf5, 0x73, 0xc1, 0x52, 0x96, 0x14, 0x53, 0x22, 0xbe, 0x30, 0x7e, 0x6d, 0x95,
-	0x67, 0x57, 0xfe, 0xf5, 0x72, 0xde, 0x94, 0x57, 0xf4, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x57, 0x8b, 0xfb, 0xd8, 0x8a, 0x02, 0x00, 0x00,
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x72, 0xe3, 0xc4,
+	0x13, 0xb6, 0x1c, 0x27, 0x76, 0xc6, 0x4e, 0xe2, 0x9d, 0xdd, 0xfc, 0xa2, 0x78, 0xeb, 0x67, 0x69,
+	0x55, 0x40, 0xa5, 0x28, 0xd6, 0x66, 0x77, 0x39, 0x50, 0x7b, 0xf3, 0xbf, 0x80, 0xaa, 0x42, 0x12,
+	0x14, 0xa7, 0x8a, 0xe5, 0xa2, 0x92, 0x35, 0x23, 0x67, 0x2a, 0x92, 0xc6, 0x48, 0xa3, 0x05, 0xbf,
+	0x01, 0xe4, 0xb4, 0xdc, 0x28, 0xaa, 0x72, 0xe2, 0xc6, 0x89, 0xc7, 0xc8, 0x71, 0x0f, 0x1c, 0x38,
+	0x79, 0x21, 0x79, 0x00, 0xaa, 0xf2, 0x02, 0x50, 0x9a, 0x19, 0xcb, 0xda, 0x70, 0x08, 0x87, 0x1c,
+	0x38, 0x59, 0xdd, 0xfd, 0x75, 0x6b, 0xe6, 0xfb, 0x5a, 0xdd, 0x06, 0xcd, 0xd0, 0x63, 0xed, 0x97,
+	0x4f, 0x46, 0x98, 0x39, 0x4f, 0xda, 0x81, 0x13, 0x9d, 0x62, 0x66, 0x4f, 0x7c, 0xc7, 0xc5, 0xad,
+	0x49, 0x44, 0x19, 0x85, 0xd5, 0xd0, 0x63, 0x2d, 0x19, 0x6f, 0x3c, 0x18, 0xd3, 0x31, 0xe5, 0xfe,
+	0x76, 0xfa, 0x24, 0x20, 0x8d, 0xa6, 0x4b, 0xe3, 0x80, 0xc6, 0xed, 0x91, 0x13, 0xe3, 0xac, 0x94,
+	0x4b, 0x49, 0x38, 0x8f, 0x8f, 0x29, 0x1d, 0xfb, 0xb8, 0xcd, 0xad, 0x51, 0xe2, 0xb5, 0x51, 0x12,
+	0x39, 0x8c, 0xd0, 0x79, 0x5c, 0xbb, 0x19, 0x67, 0x24, 0xc0, 0x31, 0x73, 0x82, 0x89, 0x00, 0x18,
+	0x3f, 0x96, 0x40, 0xf5, 0x33, 0x7e, 0xb4, 0xc3, 0xf4, 0x64, 0xf0, 0x3d, 0xb0, 0x1c, 0x7a, 0xcc,
+	0x44, 0xaa, 0xa2, 0x2b, 0x3b, 0xab, 0xdd, 0xfa, 0xf5, 0x4c, 0xab, 0x4d, 0x9d, 0xc0, 0x7f, 0x6e,
+	0xec, 0xef, 0x0e, 0xcd, 0xbe, 0x61, 0x89, 0x30, 0x7c, 0x0c, 0xca, 0x08, 0x87, 0x34, 0x30, 0xfb,
+	0x6a, 0x91, 0x23, 0xef, 0x5f, 0xcf, 0xb4, 0x0d, 0x81, 0xe4, 0x01, 0x9b, 0x20, 0xc3, 0x9a, 0x63,
+	0xe0, 0x03, 0xb0, 0x3c, 0x89, 0x88, 0x8b, 0xd5, 0xa5, 0x14, 0x6c, 0x09, 0x03, 0xfe, 0x0f, 0xac,
+	0xc4, 0xd8, 0xf7, 0x71, 0xa4, 0x96, 0xb8, 0x5b, 0x5a, 0x29, 0x7a, 0x94, 0x4c, 0x71, 0xa4, 0x2e,
+	0x0b, 0x34, 0x37, 0x52, 0xb4, 0x47, 0x7c, 0x1f, 0x23, 0x75, 0x45, 0x57, 0x76, 0x2a, 0x96, 0xb4,
+	0xe0, 0xc7, 0xa0, 0xea, 0x93, 0x98, 0x61, 0x64, 0xb3, 0xe9, 0x04, 0xab, 0x65, 0x5d, 0xd9, 0x59,
+	0x7f, 0xba, 0xd5, 0xca, 0x91, 0xdb, 0xda, 0xe3, 0xf1, 0xe1, 0x74, 0x82, 0x2d, 0xe0, 0x67, 0xcf,
+	0xb0, 0x01, 0x2a, 0x6e, 0x12, 0x45, 0x38, 0x74, 0xa7, 0x6a, 0x85, 0xbf, 0x2a, 0xb3, 0xa1, 0x06,
+	0xaa, 0x1e, 0x71, 0x98, 0xed, 0x04, 0x34, 0x09, 0x99, 0xba, 0xca, 0xc3, 0x20, 0x75, 0x75, 0xb8,
+	0x07, 0xea, 0xa0, 0x46, 0x23, 0x84, 0x23, 0x3b, 0xc2, 0x9e, 0x4d, 0x90, 0x0a, 0x04, 0x82, 0xfb,
+	0x2c, 0xec, 0x99, 0x28, 0x3d, 0xb0, 0x78, 0x99, 0x5a, 0x15, 0x07, 0x16, 0x16, 0x1c, 0x02, 0x80,
+	0xbf, 0x99, 0x90, 0x08, 0xc7, 0xb6, 0xc3, 0xd4, 0x9a, 0xae, 0xec, 0x54, 0x9f, 0x36, 0x5a, 0x42,
+	0xa9, 0xd6, 0x5c, 0xa9, 0xd6, 0x70, 0xae, 0x54, 0x77, 0xfb, 0x7a, 0xa6, 0xdd, 0x13, 0xd4, 0x2e,
+	0xf2, 0x8c, 0x57, 0x6f, 0x34, 0xc5, 0x5a, 0x95, 0x8e, 0x0e, 0x83, 0x9f, 0x83, 0x55, 0x41, 0x48,
+	0x5a, 0x74, 0xed, 0xd6, 0xa2, 0xea, 0xf5, 0x4c, 0xab, 0x8b, 0xa2, 0x59, 0x9a, 0xa8, 0x59, 0x11,
+	0x76, 0x87, 0x19, 0x7f, 0x15, 0x41, 0xb9, 0x93, 0xb8, 0x69, 0x3f, 0xc1, 0x4d, 0xb0, 0x12, 0x7a,
+	0xcc, 0x26, 0xb2, 0x33, 0xe6, 0x7d, 0xd0, 0x02, 0x95, 0xb9, 0xdc, 0xff, 0xa2, 0x11, 0x50, 0x4e,
+	0xf2, 0xa5, 0xb7, 0x24, 0xd7, 0x40, 0x35, 0x66, 0x4e, 0xc4, 0x6c, 0xd1, 0x26, 0xa2, 0x1f, 0x00,
+	0x77, 0x1d, 0xf2, 0x5e, 0xd1, 0x40, 0xf5, 0x84, 0x8c, 0x4f, 0x70, 0xcc, 0xec, 0x11, 0x41, 0xb2,
+	0x33, 0x80, 0x74, 0x75, 0x09, 0x82, 0xef, 0x82, 0xf5, 0x1c, 0x00, 0xe1, 0x88, 0xb7, 0xc9, 0xaa,
+	0xb5, 0xb6, 0xc0, 0x20, 0x1c, 0xc1, 0x2f, 0x80, 0xa8, 0x6a, 0xa7, 0x5f, 0x82, 0x5a, 0xbe, 0x95,
+	0xa7, 0xff, 0x5f, 0xcc, 0xb4, 0xc2, 0x42, 0x80, 0x45, 0xae, 0x14, 0x80, 0x3b, 0x52, 0x38, 0xb4,
+	0x40, 0x05, 0x87, 0x48, 0xd4, 0xad, 0xdc, 0x5a, 0xf7, 0xa1, 0xac, 0x2b, 0xa9, 0x9a, 0x67, 0x8a,
+	0xaa, 0x65, 0x1c, 0xa2, 0x14, 0x6a, 0x7c, 0xbf, 0x04, 0x6a, 0xfd, 0x84, 0xb9, 0x27, 0xff, 0x21,
+	0x19, 0x3c, 0x9f, 0xd2, 0x48, 0x02, 0xa4, 0x0c, 0xdc, 0x25, 0x00, 0x8f, 0x40, 0x0d, 0x61, 0xd7,
+	0x99, 0xce, 0x3f, 0x1c, 0x21, 0x42, 0x95, 0xfb, 0xe4, 0x97, 0xe3, 0x82, 0x75, 0x01, 0x21, 0x21,
+	0xc3, 0xd1, 0x4b, 0xc7, 0x97, 0x32, 0x6c, 0xff, 0x83, 0xae, 0xbe, 0x9c, 0x66, 0xdd, 0x47, 0x92,
+	0xad, 0xcd, 0xf9, 0x8d, 0xf2, 0xe9, 0xc6, 0x0f, 0x29, 0x67, 0x6b, 0xdc, 0x69, 0x4a, 0xdf, 0x0d,
+	0x9d, 0x2b, 0x77, 0xa7, 0xb3, 0xf1, 0xab, 0x02, 0x96, 0x0f, 0x3c, 0x0f, 0x47, 0x77, 0x28, 0x86,
+	0xec, 0x58, 0x29, 0x86, 0xb0, 0x52, 0xbf, 0x24, 0x51, 0x8e, 0x47, 0x61, 0xdd, 0x98, 0x1f, 0xcb,
+	0x77, 0x33, 0x3f, 0x8c, 0xef, 0x8a, 0x60, 0xa3, 0x47, 0x7d, 0x1f, 0xf3, 0x46, 0x13, 0x17, 0x5c,
+	0x07, 0x45, 0x79, 0xb9, 0x92, 0x55, 0x24, 0x77, 0x77, 0xb3, 0x6c, 0x1d, 0x94, 0xf2, 0xeb, 0xa0,
+	0x01, 0x2a, 0x5f, 0x25, 0x4e, 0xc8, 0x08, 0x9b, 0xf2, 0x5b, 0x95, 0xac, 0xcc, 0xbe, 0x31, 0xfc,
+	0x4b, 0xd9, 0xf0, 0x7f, 0x9b, 0x8b, 0xf2, 0x1d, 0x71, 0xf1, 0x8b, 0x02, 0xd6, 0x24, 0x17, 0x18,
+	0xed, 0x62, 0x1c, 0x43, 0x37, 0xd3, 0x42, 0xd1, 0x97, 0x78, 0xaf, 0x8a, 0xcd, 0xdc, 0x4a, 0x37,
+	0x73, 0xb6, 0x67, 0x7a, 0x94, 0x84, 0xdd, 0x0f, 0xd3, 0x4e, 0xfa, 0xf9, 0x8d, 0xb6, 0x33, 0x26,
+	0xec, 0x24, 0x19, 0xb5, 0x5c, 0x1a, 0xb4, 0xe5, 0x1a, 0x17, 0x3f, 0x8f, 0x63, 0x74, 0xda, 0x4e,
+	0x77, 0x55, 0xcc, 0x13, 0xe2, 0x4c, 0xd8, 0xe7, 0xa0, 0xe6, 0xd2, 0x20, 0x48, 0x42, 0xc2, 0xa6,
+	0x0b, 0x8a, 0xb7, 0xae, 0x67, 0xda, 0x7d, 0x71, 0xe4, 0x7c, 0xd4, 0xb0, 0xaa, 0x99, 0x69, 0xa2,
+	0xf7, 0xff, 0x54, 0x00, 0x58, 0xac, 0x39, 0xf8, 0x01, 0xd8, 0xda, 0x33, 0x8f, 0x86, 0x83, 0xbe,
+	0x3d, 0x7c, 0x71, 0x38, 0xb0, 0x8f, 0xf7, 0x8f, 0x0e, 0x07, 0x3d, 0x73, 0xd7, 0x1c, 0xf4, 0xeb,
+	0x85, 0xc6, 0xc6, 0xd9, 0xb9, 0x5e, 0x3d, 0x0e, 0xe3, 0x09, 0x76, 0x89, 0x47, 0x30, 0x82, 0x4d,
+	0x50, 0xcf, 0xa3, 0x77, 0xcd, 0xce, 0xb0, 0xae, 0x34, 0x2a, 0x67, 0xe7, 0x7a, 0x69, 0x97, 0x38,
+	0x0c, 0x1a, 0x00, 0xe6, 0xe3, 0x3d, 0xeb, 0xc5, 0xe1, 0xf0, 0xa0, 0x5e, 0x6c, 0x80, 0xb3, 0x73,
+	0x7d, 0xa5, 0x17, 0x4d, 0x27, 0x8c, 0xc2, 0x67, 0xe0, 0x61, 0x1e, 0x33, 0xd8, 0xff, 0x64, 0xcf,
+	0x3c, 0xfa, 0xd4, 0xee, 0x1c, 0xf7, 0x86, 0xe6, 0xc1, 0x7e, 0x7d, 0xa9, 0x01, 0xcf, 0xce, 0xf5,
+	0xf5, 0x41, 0x38, 0xf6, 0x49, 0x9c, 0x8d, 0xb3, 0x8f, 0xc0, 0x76, 0x3e, 0xa9, 0x7f, 0x3c, 0xec,
+	0x2d, 0x52, 0x4a, 0x8d, 0xcd, 0xb3, 0x73, 0xfd, 0x5e, 0x1f, 0xc7, 0x2e, 0x0e, 0x11, 0x09, 0xc7,
+	0x32, 0xab, 0x51, 0xfa, 0xf6, 0xa7, 0x66, 0xa1, 0xdb, 0xbd, 0xf8, 0xa3, 0x59, 0xb8, 0xb8, 0x6c,
+	0x2a, 0xaf, 0x2f, 0x9b, 0xca, 0xef, 0x97, 0x4d, 0xe5, 0xd5, 0x55, 0xb3, 0xf0, 0xfa, 0xaa, 0x59,
+	0xf8, 0xed, 0xaa, 0x59, 0xf8, 0xf2, 0x9d, 0x1c, 0xfb, 0x9d, 0x84, 0xd1, 0x90, 0x06, 0xd3, 0x7d,
+	0xcc, 0xbe, 0xa6, 0xd1, 0x69, 0x3b, 0xfd, 0x5f, 0xc6, 0xf9, 0x1f, 0xad, 0xf0, 0x16, 0x79, 0xf6,
+	0xf7, 0x00, 0x4e, 0x54, 0xed, 0xb3, 0xab, 0x09, 0x00, 0x00,
 }
 
 func (m *MarketPlace) Marshal() (dAtA []byte, err error) {
@@ -160,6 +428,26 @@ func (m *MarketPlace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FilledAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FilledAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FilledAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMarketPlace(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ExpiresAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMarketPlace(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Listed {
 		i--
 		if m.Listed {
@@ -244,74 +532,1627 @@ func (m *MarketPlace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMarketPlace(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarketPlace(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MarketPlace) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMarketPlace(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMarketPlace(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.HighestBidder) > 0 {
+		i -= len(m.HighestBidder)
+		copy(dAtA[i:], m.HighestBidder)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.HighestBidder)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HighestBid) > 0 {
+		i -= len(m.HighestBid)
+		copy(dAtA[i:], m.HighestBid)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.HighestBid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StartPrice) > 0 {
+		i -= len(m.StartPrice)
+		copy(dAtA[i:], m.StartPrice)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.StartPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = len(m.DenomID)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	i -= n5
+	i = encodeVarintMarketPlace(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DecayInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayInterval):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	i -= n6
+	i = encodeVarintMarketPlace(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DecayAmount) > 0 {
+		i -= len(m.DecayAmount)
+		copy(dAtA[i:], m.DecayAmount)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.DecayAmount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	if len(m.FloorPrice) > 0 {
+		i -= len(m.FloorPrice)
+		copy(dAtA[i:], m.FloorPrice)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.FloorPrice)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	if len(m.StartPrice) > 0 {
+		i -= len(m.StartPrice)
+		copy(dAtA[i:], m.StartPrice)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.StartPrice)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Filled {
-		n += 2
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ListedType != 0 {
-		n += 1 + sovMarketPlace(uint64(m.ListedType))
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Currency)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.FiatAmount)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Offer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OrderRefId)
-	if l > 0 {
-		n += 1 + l + sovMarketPlace(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMarketPlace(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Listed {
-		n += 2
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectionOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectionOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMarketPlace(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Filled != 0 {
+		i = encodeVarintMarketPlace(dAtA, i, uint64(m.Filled))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintMarketPlace(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarketPlace(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintMarketPlace(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarketPlace(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarketPlace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarketPlace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketPlace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.DenomID)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	if m.Filled {
+		n += 2
+	}
+	if m.ListedType != 0 {
+		n += 1 + sovMarketPlace(uint64(m.ListedType))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.FiatAmount)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.OrderRefId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	if m.Listed {
+		n += 2
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	if m.FilledAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FilledAt)
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	return n
+}
+
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.StartPrice)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.HighestBid)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.HighestBidder)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMarketPlace(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMarketPlace(uint64(l))
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.StartPrice)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.FloorPrice)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.DecayAmount)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayInterval)
+	n += 1 + l + sovMarketPlace(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMarketPlace(uint64(l))
+	return n
+}
+
+func (m *Offer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectionOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarketPlace(uint64(m.Id))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovMarketPlace(uint64(m.Quantity))
+	}
+	if m.Filled != 0 {
+		n += 1 + sovMarketPlace(uint64(m.Filled))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectedFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarketPlace(uint64(l))
+		}
+	}
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovMarketPlace(uint64(l))
+	}
+	return n
+}
+
+func sovMarketPlace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarketPlace(x uint64) (n int) {
+	return sovMarketPlace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketPlace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketPlace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPlace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPlace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListedType", wireType)
+			}
+			m.ListedType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListedType |= ListedType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiatAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FiatAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderRefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Listed = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FilledAt == nil {
+				m.FilledAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FilledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketPlace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketPlace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketPlace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketPlace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DecayInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketPlace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovMarketPlace(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarketPlace(x uint64) (n int) {
-	return sovMarketPlace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MarketPlace) Unmarshal(dAtA []byte) error {
+func (m *Offer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -334,10 +2175,10 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketPlace: wiretype end group for non-group")
+			return fmt.Errorf("proto: Offer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketPlace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -374,7 +2215,7 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,11 +2243,11 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomID = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -434,11 +2275,11 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -466,13 +2307,13 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketPlace
@@ -482,29 +2323,83 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketPlace
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketPlace
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketPlace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectionOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketPlace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectionOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectionOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketPlace
@@ -514,17 +2409,16 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Filled = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListedType", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
-			m.ListedType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketPlace
@@ -534,14 +2428,27 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ListedType |= ListedType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -569,11 +2476,11 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Currency = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FiatAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -601,13 +2508,51 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FiatAmount = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			m.Filled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Filled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderRefId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketPlace
@@ -617,29 +2562,83 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketPlace
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketPlace
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderRefId = string(dAtA[iNdEx:postIndex])
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketPlace(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectedFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketPlace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectedFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectedFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketPlace
@@ -649,12 +2648,58 @@ func (m *MarketPlace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Listed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPlace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketPlace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketPlace(dAtA[iNdEx:])
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsActive returns true if nfts can be minted in the phase at the given time
+func (p MintPhase) IsActive(blockTime time.Time) bool {
+	return !blockTime.Before(p.StartTime) && blockTime.Before(p.EndTime)
+}
+
+// HasPrice returns true if the phase overrides the price of its denom
+func (p MintPhase) HasPrice() bool {
+	return len(p.Price) > 0
+}
+
+// GetPrice returns the price of every nft minted in the phase
+func (p MintPhase) GetPrice() (sdk.Coin, error) {
+	if len(p.Price) == 0 {
+		return sdk.Coin{}, nil
+	}
+
+	price, err := sdk.ParseCoinNormalized(p.Price)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidMintPhase, "unable to parse the price of phase %d %s", p.Id, err.Error())
+	}
+	return price, nil
+}
+
+// IsSoldOut returns true if the supply of the phase is minted
+func (p MintPhase) IsSoldOut() bool {
+	return p.Supply > 0 && p.Minted >= p.Supply
+}
+
+// ValidateMintPhases checks the phases have unique ids, valid prices and do not overlap in time
+func ValidateMintPhases(phases []MintPhase) error {
+	seen := make(map[uint64]bool)
+	for i, phase := range phases {
+		if phase.Id == 0 {
+			return sdkerrors.Wrapf(ErrInvalidMintPhase, "phase id must be positive")
+		}
+		if seen[phase.Id] {
+			return sdkerrors.Wrapf(ErrInvalidMintPhase, "duplicate phase id %d", phase.Id)
+		}
+		seen[phase.Id] = true
+
+		if !phase.StartTime.Before(phase.EndTime) {
+			return sdkerrors.Wrapf(ErrInvalidMintPhase, "phase %d must end after it starts", phase.Id)
+		}
+
+		if _, err := phase.GetPrice(); err != nil {
+			return err
+		}
+
+		if len(phase.MerkleRoot) > 0 && len(phase.MerkleRoot) != sha256.Size {
+			return sdkerrors.Wrapf(ErrInvalidMintPhase, "merkle root of phase %d must be %d bytes", phase.Id, sha256.Size)
+		}
+
+		for _, other := range phases[:i] {
+			if phase.StartTime.Before(other.EndTime) && other.StartTime.Before(phase.EndTime) {
+				return sdkerrors.Wrapf(ErrInvalidMintPhase, "phase %d overlaps phase %d", phase.Id, other.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// MerkleLeaf returns the merkle allowlist leaf of an address, the sha256 hash of its bech32 string
+func MerkleLeaf(address sdk.AccAddress) []byte {
+	leaf := sha256.Sum256([]byte(address.String()))
+	return leaf[:]
+}
+
+// VerifyMerkleProof returns true if the proof links the leaf to the root. Pairs of nodes are
+// sorted before they are hashed so proofs do not need to record the side of every sibling.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		var pair []byte
+		if bytes.Compare(node, sibling) <= 0 {
+			pair = append(append(pair, node...), sibling...)
+		} else {
+			pair = append(append(pair, sibling...), node...)
+		}
+		hash := sha256.Sum256(pair)
+		node = hash[:]
+	}
+	return bytes.Equal(node, root)
+}
@@ -0,0 +1,1270 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/mint_phase.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintPhase is a window of a primary sale drop with its own price, supply and wallet limit
+type MintPhase struct {
+	Id        uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DenomId   string    `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Name      string    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// price is paid for every nft minted in the phase. Empty falls back to the price of the denom,
+	// a zero price such as "0stake" makes the phase free.
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// wallet_limit is the number of nfts a wallet can mint in the phase. Zero is unlimited.
+	WalletLimit uint64 `protobuf:"varint,7,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty" yaml:"wallet_limit"`
+	// supply is the number of nfts that can be minted in the phase. Zero is limited by the denom only.
+	Supply uint64 `protobuf:"varint,8,opt,name=supply,proto3" json:"supply,omitempty"`
+	Minted uint64 `protobuf:"varint,9,opt,name=minted,proto3" json:"minted,omitempty"`
+	// allowlist_only restricts minting to the allowlisted addresses or holders of a merkle proof
+	AllowlistOnly bool   `protobuf:"varint,10,opt,name=allowlist_only,json=allowlistOnly,proto3" json:"allowlist_only,omitempty" yaml:"allowlist_only"`
+	MerkleRoot    []byte `protobuf:"bytes,11,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+}
+
+func (m *MintPhase) Reset()         { *m = MintPhase{} }
+func (m *MintPhase) String() string { return proto.CompactTextString(m) }
+func (*MintPhase) ProtoMessage()    {}
+func (*MintPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ce7bec6dca3fd, []int{0}
+}
+func (m *MintPhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPhase.Merge(m, src)
+}
+func (m *MintPhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPhase proto.InternalMessageInfo
+
+// PhaseAllowlist is the list of addresses allowed to mint in a phase
+type PhaseAllowlist struct {
+	DenomId   string   `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	PhaseId   uint64   `protobuf:"varint,2,opt,name=phase_id,json=phaseId,proto3" json:"phase_id,omitempty" yaml:"phase_id"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *PhaseAllowlist) Reset()         { *m = PhaseAllowlist{} }
+func (m *PhaseAllowlist) String() string { return proto.CompactTextString(m) }
+func (*PhaseAllowlist) ProtoMessage()    {}
+func (*PhaseAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ce7bec6dca3fd, []int{1}
+}
+func (m *PhaseAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PhaseAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PhaseAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseAllowlist.Merge(m, src)
+}
+func (m *PhaseAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseAllowlist proto.InternalMessageInfo
+
+// WalletMints is the number of nfts a wallet minted in a phase
+type WalletMints struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	PhaseId uint64 `protobuf:"varint,2,opt,name=phase_id,json=phaseId,proto3" json:"phase_id,omitempty" yaml:"phase_id"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Count   uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *WalletMints) Reset()         { *m = WalletMints{} }
+func (m *WalletMints) String() string { return proto.CompactTextString(m) }
+func (*WalletMints) ProtoMessage()    {}
+func (*WalletMints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ce7bec6dca3fd, []int{2}
+}
+func (m *WalletMints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WalletMints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WalletMints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WalletMints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletMints.Merge(m, src)
+}
+func (m *WalletMints) XXX_Size() int {
+	return m.Size()
+}
+func (m *WalletMints) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletMints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletMints proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MintPhase)(nil), "nft.v1beta1.MintPhase")
+	proto.RegisterType((*PhaseAllowlist)(nil), "nft.v1beta1.PhaseAllowlist")
+	proto.RegisterType((*WalletMints)(nil), "nft.v1beta1.WalletMints")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/mint_phase.proto", fileDescriptor_ce4ce7bec6dca3fd) }
+
+var fileDescriptor_ce4ce7bec6dca3fd = []byte{
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4d, 0x4f, 0xd5, 0x40,
+	0x14, 0x7d, 0x03, 0x85, 0xf7, 0x3a, 0x45, 0x8c, 0x03, 0xe2, 0x88, 0xd8, 0x36, 0x8d, 0x8b, 0xae,
+	0xda, 0xa0, 0x0b, 0x13, 0x56, 0xd2, 0x1d, 0x89, 0x5f, 0x99, 0x98, 0x68, 0xdc, 0x34, 0x7d, 0x74,
+	0x78, 0x34, 0x4c, 0x67, 0x9a, 0x76, 0x9e, 0xa4, 0x7f, 0xc2, 0xf0, 0x07, 0x4c, 0xfc, 0x39, 0x2c,
+	0x59, 0xba, 0xaa, 0x0a, 0x1b, 0xd7, 0xef, 0x17, 0x98, 0x99, 0x69, 0x05, 0x56, 0xba, 0x71, 0x77,
+	0xcf, 0x39, 0x77, 0xce, 0xbd, 0xef, 0xbe, 0x53, 0xb8, 0xc3, 0x8f, 0x64, 0xfc, 0x69, 0x77, 0x4a,
+	0x65, 0xb6, 0x1b, 0x97, 0x05, 0x97, 0x69, 0x75, 0x9c, 0x35, 0x34, 0xaa, 0x6a, 0x21, 0x05, 0x72,
+	0xf8, 0x91, 0x8c, 0x7a, 0x75, 0x7b, 0x73, 0x26, 0x66, 0x42, 0xf3, 0xb1, 0xaa, 0x4c, 0xcb, 0xb6,
+	0x37, 0x13, 0x62, 0xc6, 0x68, 0xac, 0xd1, 0x74, 0x7e, 0x14, 0xcb, 0xa2, 0xa4, 0x8d, 0xcc, 0xca,
+	0xca, 0x34, 0x04, 0x67, 0x16, 0xb4, 0x5f, 0x15, 0x5c, 0xbe, 0x55, 0xbe, 0x68, 0x1d, 0x2e, 0x15,
+	0x39, 0x06, 0x3e, 0x08, 0x2d, 0xb2, 0x54, 0xe4, 0x28, 0x82, 0x93, 0x9c, 0x72, 0x51, 0xa6, 0x45,
+	0x8e, 0x97, 0x7c, 0x10, 0xda, 0xc9, 0xc6, 0xa2, 0xf3, 0xee, 0xb6, 0x59, 0xc9, 0xf6, 0x82, 0x41,
+	0x09, 0xc8, 0x58, 0x97, 0x07, 0x39, 0x42, 0xd0, 0xe2, 0x59, 0x49, 0xf1, 0xb2, 0xea, 0x25, 0xba,
+	0x46, 0x1f, 0x20, 0x6c, 0x64, 0x56, 0xcb, 0x54, 0x8d, 0xc6, 0x96, 0x0f, 0x42, 0xe7, 0xe9, 0x76,
+	0x64, 0xf6, 0x8a, 0x86, 0xbd, 0xa2, 0x77, 0xc3, 0x5e, 0xc9, 0xe3, 0xf3, 0xce, 0x1b, 0x2d, 0x3a,
+	0xef, 0x9e, 0x99, 0x72, 0xfd, 0x36, 0x38, 0xfb, 0xee, 0x01, 0x62, 0x6b, 0x42, 0xb5, 0x23, 0x02,
+	0x27, 0x94, 0xe7, 0xc6, 0x77, 0xe5, 0xaf, 0xbe, 0x8f, 0x7a, 0xdf, 0x7e, 0xfb, 0xe1, 0xa5, 0x71,
+	0x1d, 0x53, 0x9e, 0x6b, 0xcf, 0x4d, 0xb8, 0x52, 0xd5, 0xc5, 0x21, 0xc5, 0xab, 0xfa, 0x27, 0x18,
+	0x80, 0xf6, 0xe0, 0xda, 0x69, 0xc6, 0x18, 0x95, 0x29, 0x2b, 0xca, 0x42, 0xe2, 0xb1, 0xba, 0x50,
+	0xf2, 0x60, 0xd1, 0x79, 0x1b, 0xc6, 0xed, 0xa6, 0x1a, 0x10, 0xc7, 0xc0, 0x97, 0x0a, 0xa1, 0x2d,
+	0xb8, 0xda, 0xcc, 0xab, 0x8a, 0xb5, 0x78, 0xa2, 0xef, 0xda, 0x23, 0xc5, 0xab, 0x7f, 0x94, 0xe6,
+	0xd8, 0x36, 0xbc, 0x41, 0xe8, 0x05, 0x5c, 0xcf, 0x18, 0x13, 0xa7, 0xac, 0x68, 0x64, 0x2a, 0x38,
+	0x6b, 0x31, 0xf4, 0x41, 0x38, 0x49, 0x1e, 0x2e, 0x3a, 0xef, 0xbe, 0x99, 0x76, 0x5b, 0x0f, 0xc8,
+	0x9d, 0x3f, 0xc4, 0x1b, 0xce, 0x5a, 0xf4, 0x1c, 0x3a, 0x25, 0xad, 0x4f, 0x18, 0x4d, 0x6b, 0x21,
+	0x24, 0x76, 0x7c, 0x10, 0xae, 0x25, 0x5b, 0x8b, 0xce, 0x43, 0xe6, 0xf9, 0x0d, 0x31, 0x20, 0xd0,
+	0x20, 0x22, 0x84, 0xdc, 0xb3, 0x7e, 0x7d, 0xf5, 0x40, 0xf0, 0x19, 0xc0, 0x75, 0x1d, 0x87, 0xfd,
+	0xc1, 0xf5, 0x56, 0x0e, 0xc0, 0x3f, 0xe4, 0x20, 0x82, 0x13, 0x1d, 0xd4, 0x21, 0x37, 0xd6, 0xcd,
+	0xfe, 0x41, 0x09, 0xc8, 0x58, 0x97, 0x07, 0x39, 0xda, 0x81, 0x76, 0x96, 0xe7, 0x35, 0x6d, 0x1a,
+	0xda, 0xe0, 0x65, 0x7f, 0x39, 0xb4, 0xc9, 0x35, 0x11, 0x7c, 0x01, 0xd0, 0x79, 0xaf, 0x2f, 0xaa,
+	0x92, 0xda, 0xfc, 0xf7, 0x6d, 0x30, 0x1c, 0xf7, 0xc3, 0xfb, 0x20, 0x0f, 0x50, 0xa5, 0xe3, 0x50,
+	0xcc, 0xb9, 0xd4, 0x31, 0xb6, 0x88, 0x01, 0x49, 0x72, 0xfe, 0xd3, 0x1d, 0x9d, 0x5f, 0xba, 0xe0,
+	0xe2, 0xd2, 0x05, 0x3f, 0x2e, 0x5d, 0x70, 0x76, 0xe5, 0x8e, 0x2e, 0xae, 0xdc, 0xd1, 0xb7, 0x2b,
+	0x77, 0xf4, 0xf1, 0xc9, 0xac, 0x90, 0xc7, 0xf3, 0x69, 0x74, 0x28, 0xca, 0x78, 0x7f, 0x2e, 0x05,
+	0x17, 0x65, 0xfb, 0x9a, 0xca, 0x53, 0x51, 0x9f, 0xc4, 0xea, 0xf3, 0x96, 0x6d, 0x45, 0x9b, 0xe9,
+	0xaa, 0x4e, 0xec, 0xb3, 0xdf, 0x03, 0x00, 0xe7, 0x7e, 0xed, 0x51, 0xf2, 0x03, 0x00, 0x00,
+}
+
+func (this *MintPhase) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintPhase)
+	if !ok {
+		that2, ok := that.(MintPhase)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if this.Price != that1.Price {
+		return false
+	}
+	if this.WalletLimit != that1.WalletLimit {
+		return false
+	}
+	if this.Supply != that1.Supply {
+		return false
+	}
+	if this.Minted != that1.Minted {
+		return false
+	}
+	if this.AllowlistOnly != that1.AllowlistOnly {
+		return false
+	}
+	if !bytes.Equal(this.MerkleRoot, that1.MerkleRoot) {
+		return false
+	}
+	return true
+}
+func (m *MintPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AllowlistOnly {
+		i--
+		if m.AllowlistOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Minted != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Supply != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.Supply))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WalletLimit != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.WalletLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintPhase(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintPhase(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PhaseAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintMintPhase(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PhaseId != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.PhaseId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WalletMints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalletMints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalletMints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PhaseId != 0 {
+		i = encodeVarintMintPhase(dAtA, i, uint64(m.PhaseId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMintPhase(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintPhase(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintPhase(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMintPhase(uint64(m.Id))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMintPhase(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMintPhase(uint64(l))
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	if m.WalletLimit != 0 {
+		n += 1 + sovMintPhase(uint64(m.WalletLimit))
+	}
+	if m.Supply != 0 {
+		n += 1 + sovMintPhase(uint64(m.Supply))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovMintPhase(uint64(m.Minted))
+	}
+	if m.AllowlistOnly {
+		n += 2
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	return n
+}
+
+func (m *PhaseAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	if m.PhaseId != 0 {
+		n += 1 + sovMintPhase(uint64(m.PhaseId))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovMintPhase(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WalletMints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	if m.PhaseId != 0 {
+		n += 1 + sovMintPhase(uint64(m.PhaseId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMintPhase(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMintPhase(uint64(m.Count))
+	}
+	return n
+}
+
+func sovMintPhase(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintPhase(x uint64) (n int) {
+	return sovMintPhase(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintPhase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+			}
+			m.WalletLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			m.Supply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistOnly = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintPhase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhaseAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintPhase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhaseAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhaseAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseId", wireType)
+			}
+			m.PhaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintPhase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalletMints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintPhase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletMints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletMints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseId", wireType)
+			}
+			m.PhaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintPhase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintPhase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintPhase(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintPhase
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintPhase
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintPhase
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintPhase
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintPhase
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintPhase        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintPhase          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintPhase = fmt.Errorf("proto: unexpected end of group")
+)
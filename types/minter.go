@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMinter creates a new Minter instance
+func NewMinter(denomID string, address sdk.AccAddress, quota uint64, expiresAt *time.Time) Minter {
+	return Minter{
+		DenomId:   denomID,
+		Address:   address.String(),
+		Quota:     quota,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (m Minter) GetAddress() sdk.AccAddress {
+	address, _ := sdk.AccAddressFromBech32(m.Address)
+	return address
+}
+
+// IsExpired returns true if the mint rights ended at the given time
+func (m Minter) IsExpired(now time.Time) bool {
+	return m.ExpiresAt != nil && !now.Before(*m.ExpiresAt)
+}
+
+// IsQuotaReached returns true if the minter minted all the nfts it was allowed to
+func (m Minter) IsQuotaReached() bool {
+	return m.Quota > 0 && m.Minted >= m.Quota
+}
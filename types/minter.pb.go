@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/minter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Minter is an address the creator of a denom allowed to mint into the denom
+type Minter struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// quota is the number of nfts the minter can mint. Zero is unlimited.
+	Quota  uint64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	Minted uint64 `protobuf:"varint,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	// expires_at is the time the mint rights end. Empty never expires.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+}
+
+func (m *Minter) Reset()         { *m = Minter{} }
+func (m *Minter) String() string { return proto.CompactTextString(m) }
+func (*Minter) ProtoMessage()    {}
+func (*Minter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8edbec1734c9748b, []int{0}
+}
+func (m *Minter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Minter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minter.Merge(m, src)
+}
+func (m *Minter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Minter proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Minter)(nil), "nft.v1beta1.Minter")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/minter.proto", fileDescriptor_8edbec1734c9748b) }
+
+var fileDescriptor_8edbec1734c9748b = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0xe8, 0x0f, 0x75, 0x07, 0x44, 0xa8, 0x90, 0xe9, 0xe0, 0x54, 0x11, 0x43, 0x27,
+	0x5b, 0x85, 0x8d, 0xad, 0xd9, 0x18, 0x60, 0x88, 0x3a, 0xb1, 0x54, 0x09, 0x76, 0x43, 0x44, 0x1d,
+	0x87, 0xe4, 0x06, 0xe8, 0x5b, 0xf4, 0xb1, 0x3a, 0x76, 0x42, 0x4c, 0x05, 0xda, 0x37, 0xe8, 0x13,
+	0xa0, 0x3a, 0x89, 0xd8, 0xee, 0x77, 0xee, 0xb1, 0xfd, 0xc9, 0x98, 0x24, 0x33, 0xe0, 0x6f, 0xa3,
+	0x50, 0x42, 0x30, 0xe2, 0x2a, 0x4e, 0x40, 0x66, 0x2c, 0xcd, 0x34, 0x68, 0xbb, 0x9b, 0xcc, 0x80,
+	0x55, 0x9b, 0x7e, 0x2f, 0xd2, 0x91, 0x36, 0x39, 0x3f, 0x4c, 0x65, 0xa5, 0xef, 0x44, 0x5a, 0x47,
+	0x73, 0xc9, 0x0d, 0x85, 0xc5, 0x8c, 0x43, 0xac, 0x64, 0x0e, 0x81, 0x4a, 0xcb, 0x82, 0xfb, 0x89,
+	0x70, 0xeb, 0xde, 0x5c, 0x6a, 0x33, 0x7c, 0x22, 0x64, 0xa2, 0xd5, 0x34, 0x16, 0x04, 0x0d, 0xd0,
+	0xb0, 0xe3, 0x9d, 0xef, 0x37, 0xce, 0xe9, 0x22, 0x50, 0xf3, 0x5b, 0xb7, 0xde, 0xb8, 0x7e, 0xdb,
+	0x8c, 0x77, 0xc2, 0x26, 0xb8, 0x1d, 0x08, 0x91, 0xc9, 0x3c, 0x27, 0x47, 0x87, 0xba, 0x5f, 0xa3,
+	0xdd, 0xc3, 0xcd, 0xd7, 0x42, 0x43, 0x40, 0x8e, 0x07, 0x68, 0xd8, 0xf0, 0x4b, 0xb0, 0x2f, 0x70,
+	0xcb, 0xe8, 0x0b, 0xd2, 0x30, 0x71, 0x45, 0xf6, 0x04, 0x63, 0xf9, 0x91, 0xc6, 0x99, 0xcc, 0xa7,
+	0x01, 0x90, 0xe6, 0x00, 0x0d, 0xbb, 0xd7, 0x7d, 0x56, 0x8a, 0xb3, 0x5a, 0x9c, 0x4d, 0x6a, 0x71,
+	0xef, 0x72, 0xbf, 0x71, 0xce, 0x4a, 0xab, 0xff, 0x73, 0xee, 0xf2, 0xdb, 0x41, 0x7e, 0xa7, 0x0a,
+	0xc6, 0xe0, 0x79, 0xab, 0x5f, 0x6a, 0xad, 0xb6, 0x14, 0xad, 0xb7, 0x14, 0xfd, 0x6c, 0x29, 0x5a,
+	0xee, 0xa8, 0xb5, 0xde, 0x51, 0xeb, 0x6b, 0x47, 0xad, 0xc7, 0xab, 0x28, 0x86, 0xe7, 0x22, 0x64,
+	0x4f, 0x5a, 0xf1, 0x71, 0x01, 0x3a, 0xd1, 0x6a, 0xf1, 0x20, 0xe1, 0x5d, 0x67, 0x2f, 0xfc, 0xf0,
+	0xdf, 0xb0, 0x48, 0x65, 0x1e, 0xb6, 0xcc, 0xeb, 0x37, 0x7f, 0x03, 0x00, 0xf2, 0x42, 0x3f, 0x89,
+	0x83, 0x01, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMinter(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Minted != 0 {
+		i = encodeVarintMinter(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quota != 0 {
+		i = encodeVarintMinter(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMinter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintMinter(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMinter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMinter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	if m.Quota != 0 {
+		n += 1 + sovMinter(uint64(m.Quota))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovMinter(uint64(m.Minted))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	return n
+}
+
+func sovMinter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMinter(x uint64) (n int) {
+	return sovMinter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMinter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMinter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMinter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMinter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMinter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMinter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMinter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
 	"regexp"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,17 +22,57 @@ const (
 )
 
 const (
-	TypeCreateDenom          = "create_denom"
-	TypeMintNFT              = "mint_nft"
-	TypeUpdateNFT            = "update_nft"
-	TypeTransferNFT          = "transfer_nft"
-	TypeSellNFT              = "sell_nft"
-	TypeBuyNFT               = "buy_nft"
-	TypeCreateCommunity      = "create_community"
-	TypeJoinCommunity        = "join_community"
-	TypeUpdtaeCommunity      = "update_community"
-	TypeUpdateDenom          = "update_denom"
-	TypeDeleteMarketPlaceNFT = "delete_market_place_nft"
+	TypeCreateDenom           = "create_denom"
+	TypeMintNFT               = "mint_nft"
+	TypeUpdateNFT             = "update_nft"
+	TypeTransferNFT           = "transfer_nft"
+	TypeSellNFT               = "sell_nft"
+	TypeBuyNFT                = "buy_nft"
+	TypeCreateCommunity       = "create_community"
+	TypeJoinCommunity         = "join_community"
+	TypeUpdtaeCommunity       = "update_community"
+	TypeUpdateDenom           = "update_denom"
+	TypeDeleteMarketPlaceNFT  = "delete_market_place_nft"
+	TypeBurnNFT               = "burn_nft"
+	TypeCreateAuction         = "create_auction"
+	TypePlaceBid              = "place_bid"
+	TypeCancelAuction         = "cancel_auction"
+	TypeCreateDutchAuction    = "create_dutch_auction"
+	TypeMakeOffer             = "make_offer"
+	TypeCancelOffer           = "cancel_offer"
+	TypeAcceptOffer           = "accept_offer"
+	TypeMakeCollectionOffer   = "make_collection_offer"
+	TypeCancelCollectionOffer = "cancel_collection_offer"
+	TypeAcceptCollectionOffer = "accept_collection_offer"
+	TypeUpdateParams          = "update_params"
+	TypeSetMintPhases         = "set_mint_phases"
+	TypeSetAllowlist          = "set_allowlist"
+	TypeRedeemVoucher         = "redeem_voucher"
+	TypeGrantMinter           = "grant_minter"
+	TypeRevokeMinter          = "revoke_minter"
+	TypeTransferDenomOwner    = "transfer_denom_ownership"
+	TypeAcceptDenomOwner      = "accept_denom_ownership"
+	TypeDeleteCommunity       = "delete_community"
+	TypeTransferCommunity     = "transfer_community_ownership"
+	TypeGrantCommunityRole    = "grant_community_role"
+	TypeRevokeCommunityRole   = "revoke_community_role"
+	TypeSetMembershipPolicy   = "set_membership_policy"
+	TypeCreateCommunityInvite = "create_community_invite"
+	TypeApproveJoinRequest    = "approve_join_request"
+	TypeLeaveCommunity        = "leave_community"
+	TypeRemoveMember          = "remove_member"
+	TypeSetTreasuryCut        = "set_treasury_cut"
+	TypeCommunitySpend        = "community_spend"
+	TypeSetGovernance         = "set_community_governance"
+	TypeSubmitProposal        = "submit_community_proposal"
+	TypeVoteProposal          = "vote_community_proposal"
+	TypeCreateBadgeDenom      = "create_badge_denom"
+	TypeIssueBadge            = "issue_badge"
+	TypeRevokeBadge           = "revoke_badge"
+	TypeApproveNFT            = "approve_nft"
+	TypeRevokeNFTApproval     = "revoke_nft_approval"
+	TypeApproveOperator       = "approve_operator"
+	TypeRevokeOperator        = "revoke_operator"
 )
 
 var (
@@ -52,9 +94,49 @@ var (
 	_ sdk.Msg = &MsgUpdateCommunity{}
 	_ sdk.Msg = &MsgUpdateDenom{}
 	_ sdk.Msg = &MsgDeleteMarketPlaceNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgCreateAuction{}
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgCancelAuction{}
+	_ sdk.Msg = &MsgCreateDutchAuction{}
+	_ sdk.Msg = &MsgMakeOffer{}
+	_ sdk.Msg = &MsgCancelOffer{}
+	_ sdk.Msg = &MsgAcceptOffer{}
+	_ sdk.Msg = &MsgMakeCollectionOffer{}
+	_ sdk.Msg = &MsgCancelCollectionOffer{}
+	_ sdk.Msg = &MsgAcceptCollectionOffer{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetMintPhases{}
+	_ sdk.Msg = &MsgSetAllowlist{}
+	_ sdk.Msg = &MsgRedeemVoucher{}
+	_ sdk.Msg = &MsgGrantMinter{}
+	_ sdk.Msg = &MsgRevokeMinter{}
+	_ sdk.Msg = &MsgTransferDenomOwnership{}
+	_ sdk.Msg = &MsgAcceptDenomOwnership{}
+	_ sdk.Msg = &MsgDeleteCommunityRequest{}
+	_ sdk.Msg = &MsgTransferCommunityOwnership{}
+	_ sdk.Msg = &MsgGrantCommunityRole{}
+	_ sdk.Msg = &MsgRevokeCommunityRole{}
+	_ sdk.Msg = &MsgSetMembershipPolicy{}
+	_ sdk.Msg = &MsgCreateCommunityInvite{}
+	_ sdk.Msg = &MsgApproveJoinRequest{}
+	_ sdk.Msg = &MsgLeaveCommunity{}
+	_ sdk.Msg = &MsgRemoveMember{}
+	_ sdk.Msg = &MsgSetTreasuryCut{}
+	_ sdk.Msg = &MsgCommunitySpend{}
+	_ sdk.Msg = &MsgSetCommunityGovernance{}
+	_ sdk.Msg = &MsgSubmitCommunityProposal{}
+	_ sdk.Msg = &MsgVoteCommunityProposal{}
+	_ sdk.Msg = &MsgCreateBadgeDenom{}
+	_ sdk.Msg = &MsgIssueBadge{}
+	_ sdk.Msg = &MsgRevokeBadge{}
+	_ sdk.Msg = &MsgApproveNFT{}
+	_ sdk.Msg = &MsgRevokeNFTApproval{}
+	_ sdk.Msg = &MsgApproveOperator{}
+	_ sdk.Msg = &MsgRevokeOperator{}
 )
 
-func NewMsgCreateDenom(name, symbol, description, preview_uri, creator, community_id string, dependecy_collection []string) *MsgCreateDenom {
+func NewMsgCreateDenom(name, symbol, description, preview_uri, creator, community_id string, dependecy_collection []string, royaltyShares []RoyaltyShare, tokenGate TokenGate) *MsgCreateDenom {
 	return &MsgCreateDenom{
 		Id:                 GenUniqueID(DenomPrefix),
 		Name:               name,
@@ -64,6 +146,8 @@ func NewMsgCreateDenom(name, symbol, description, preview_uri, creator, communit
 		Creator:            creator,
 		CommunityId:        community_id,
 		DepedentCollection: dependecy_collection,
+		RoyaltyShares:      royaltyShares,
+		TokenGate:          tokenGate,
 	}
 }
 
@@ -88,7 +172,11 @@ func (msg MsgCreateDenom) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+
+	if err := msg.TokenGate.Validate(); err != nil {
+		return err
+	}
+	return ValidateRoyaltyShares(msg.RoyaltyShares)
 }
 
 func (msg MsgCreateDenom) GetSignBytes() []byte {
@@ -105,15 +193,16 @@ func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
-func NewMsgMintNFT(denomId, data, creator, royalties string, metadata Metadata, transferable bool) *MsgMintNFT {
+func NewMsgMintNFT(denomId, data, creator, royalties string, metadata Metadata, transferable bool, royaltyShares []RoyaltyShare) *MsgMintNFT {
 	return &MsgMintNFT{
-		Id:           GenUniqueID(NFTPrefix),
-		DenomId:      denomId,
-		Data:         data,
-		Creator:      creator,
-		Royalties:    royalties,
-		Metadata:     metadata,
-		Transferable: transferable,
+		Id:            GenUniqueID(NFTPrefix),
+		DenomId:       denomId,
+		Data:          data,
+		Creator:       creator,
+		Royalties:     royalties,
+		Metadata:      metadata,
+		Transferable:  transferable,
+		RoyaltyShares: royaltyShares,
 	}
 }
 func (msg MsgMintNFT) Route() string { return RouterKey }
@@ -150,7 +239,7 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidateRoyaltyShares(msg.RoyaltyShares)
 }
 
 func (msg MsgMintNFT) GetSignBytes() []byte {
@@ -240,12 +329,13 @@ func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
-func NewMsgSellNFT(nftID, denomID, price, seller string) *MsgSellNFT {
+func NewMsgSellNFT(nftID, denomID, price, seller string, expiresAt *time.Time) *MsgSellNFT {
 	return &MsgSellNFT{
-		Id:      nftID,
-		DenomId: denomID,
-		Price:   price,
-		Seller:  seller,
+		Id:        nftID,
+		DenomId:   denomID,
+		Price:     price,
+		Seller:    seller,
+		ExpiresAt: expiresAt,
 	}
 }
 
@@ -348,10 +438,11 @@ func (msg MsgCreateCommunity) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
-func NewMsgJoinCommunity(id, creator string) *MsgJoinCommunity {
+func NewMsgJoinCommunity(id, creator, inviteCode string) *MsgJoinCommunity {
 	return &MsgJoinCommunity{
 		CommunityId: id,
 		Address:     creator,
+		InviteCode:  inviteCode,
 	}
 }
 
@@ -360,7 +451,14 @@ func (msg MsgJoinCommunity) Route() string { return RouterKey }
 func (msg MsgJoinCommunity) Type() string { return TypeJoinCommunity }
 
 func (msg MsgJoinCommunity) ValidateBasic() error {
-	return nil // TODO: validate basic
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+	return nil
 }
 
 func (msg MsgJoinCommunity) GetSignBytes() []byte {
@@ -471,3 +569,1624 @@ func (msg MsgDeleteMarketPlaceNFT) GetSigners() []sdk.AccAddress {
 	from, _ := sdk.AccAddressFromBech32(msg.Address)
 	return []sdk.AccAddress{from}
 }
+
+func NewMsgBurnNFT(id, denomId, sender string) *MsgBurnNFT {
+	return &MsgBurnNFT{
+		Id:      id,
+		DenomId: denomId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgBurnNFT) Route() string { return RouterKey }
+
+func (msg MsgBurnNFT) Type() string { return TypeBurnNFT }
+
+func (msg MsgBurnNFT) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgBurnNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateAuction(id, denomId, startPrice string, duration time.Duration, seller string) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Id:         id,
+		DenomId:    denomId,
+		StartPrice: startPrice,
+		Duration:   duration,
+		Seller:     seller,
+	}
+}
+
+func (msg MsgCreateAuction) Route() string { return RouterKey }
+
+func (msg MsgCreateAuction) Type() string { return TypeCreateAuction }
+
+func (msg MsgCreateAuction) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	startPrice, err := sdk.ParseCoinNormalized(msg.StartPrice)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBid, "invalid start price %s", err)
+	}
+
+	if !startPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBid, "start price must be positive")
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction duration must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Seller)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgPlaceBid(id, denomId, amount, bidder string) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Id:      id,
+		DenomId: denomId,
+		Amount:  amount,
+		Bidder:  bidder,
+	}
+}
+
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+func (msg MsgPlaceBid) Type() string { return TypePlaceBid }
+
+func (msg MsgPlaceBid) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBid, "invalid bid amount %s", err)
+	}
+
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBid, "bid amount must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Bidder)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelAuction(id, denomId, seller string) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		Id:      id,
+		DenomId: denomId,
+		Seller:  seller,
+	}
+}
+
+func (msg MsgCancelAuction) Route() string { return RouterKey }
+
+func (msg MsgCancelAuction) Type() string { return TypeCancelAuction }
+
+func (msg MsgCancelAuction) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCancelAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Seller)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateDutchAuction(id, denomId, startPrice, floorPrice, decayAmount string, decayInterval time.Duration, seller string) *MsgCreateDutchAuction {
+	return &MsgCreateDutchAuction{
+		Id:            id,
+		DenomId:       denomId,
+		StartPrice:    startPrice,
+		FloorPrice:    floorPrice,
+		DecayAmount:   decayAmount,
+		DecayInterval: decayInterval,
+		Seller:        seller,
+	}
+}
+
+func (msg MsgCreateDutchAuction) Route() string { return RouterKey }
+
+func (msg MsgCreateDutchAuction) Type() string { return TypeCreateDutchAuction }
+
+func (msg MsgCreateDutchAuction) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if err := ValidateDutchAuctionPrices(msg.StartPrice, msg.FloorPrice, msg.DecayAmount); err != nil {
+		return err
+	}
+
+	if msg.DecayInterval <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay interval must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCreateDutchAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateDutchAuction) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Seller)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgMakeOffer(id, denomId, amount string, expiresAt *time.Time, bidder string) *MsgMakeOffer {
+	return &MsgMakeOffer{
+		Id:        id,
+		DenomId:   denomId,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgMakeOffer) Route() string { return RouterKey }
+
+func (msg MsgMakeOffer) Type() string { return TypeMakeOffer }
+
+func (msg MsgMakeOffer) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "invalid offer amount %s", err)
+	}
+
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offer amount must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgMakeOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Bidder)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelOffer(id, denomId, bidder string) *MsgCancelOffer {
+	return &MsgCancelOffer{
+		Id:      id,
+		DenomId: denomId,
+		Bidder:  bidder,
+	}
+}
+
+func (msg MsgCancelOffer) Route() string { return RouterKey }
+
+func (msg MsgCancelOffer) Type() string { return TypeCancelOffer }
+
+func (msg MsgCancelOffer) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCancelOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Bidder)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptOffer(id, denomId, bidder, owner string) *MsgAcceptOffer {
+	return &MsgAcceptOffer{
+		Id:      id,
+		DenomId: denomId,
+		Bidder:  bidder,
+		Owner:   owner,
+	}
+}
+
+func (msg MsgAcceptOffer) Route() string { return RouterKey }
+
+func (msg MsgAcceptOffer) Type() string { return TypeAcceptOffer }
+
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgAcceptOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgMakeCollectionOffer(denomId, price string, quantity uint64, expiresAt *time.Time, bidder string) *MsgMakeCollectionOffer {
+	return &MsgMakeCollectionOffer{
+		DenomId:   denomId,
+		Price:     price,
+		Quantity:  quantity,
+		ExpiresAt: expiresAt,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgMakeCollectionOffer) Route() string { return RouterKey }
+
+func (msg MsgMakeCollectionOffer) Type() string { return TypeMakeCollectionOffer }
+
+func (msg MsgMakeCollectionOffer) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	price, err := sdk.ParseCoinNormalized(msg.Price)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "invalid offer price %s", err)
+	}
+
+	if !price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offer price must be positive")
+	}
+
+	if msg.Quantity == 0 {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offer quantity must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgMakeCollectionOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgMakeCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Bidder)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelCollectionOffer(offerId uint64, bidder string) *MsgCancelCollectionOffer {
+	return &MsgCancelCollectionOffer{
+		OfferId: offerId,
+		Bidder:  bidder,
+	}
+}
+
+func (msg MsgCancelCollectionOffer) Route() string { return RouterKey }
+
+func (msg MsgCancelCollectionOffer) Type() string { return TypeCancelCollectionOffer }
+
+func (msg MsgCancelCollectionOffer) ValidateBasic() error {
+	if msg.OfferId == 0 {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offer id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCancelCollectionOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Bidder)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptCollectionOffer(offerId uint64, id, owner string) *MsgAcceptCollectionOffer {
+	return &MsgAcceptCollectionOffer{
+		OfferId: offerId,
+		Id:      id,
+		Owner:   owner,
+	}
+}
+
+func (msg MsgAcceptCollectionOffer) Route() string { return RouterKey }
+
+func (msg MsgAcceptCollectionOffer) Type() string { return TypeAcceptCollectionOffer }
+
+func (msg MsgAcceptCollectionOffer) ValidateBasic() error {
+	if msg.OfferId == 0 {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offer id must be positive")
+	}
+
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgAcceptCollectionOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAcceptCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetMintPhases(denomId string, phases []MintPhase, sender string) *MsgSetMintPhases {
+	return &MsgSetMintPhases{
+		DenomId: denomId,
+		Phases:  phases,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgSetMintPhases) Route() string { return RouterKey }
+
+func (msg MsgSetMintPhases) Type() string { return TypeSetMintPhases }
+
+func (msg MsgSetMintPhases) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	for _, phase := range msg.Phases {
+		if len(phase.DenomId) > 0 && phase.DenomId != msg.DenomId {
+			return sdkerrors.Wrapf(ErrInvalidMintPhase, "phase %d belongs to denom %s", phase.Id, phase.DenomId)
+		}
+	}
+
+	if err := ValidateMintPhases(msg.Phases); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetMintPhases) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetMintPhases) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetAllowlist(denomId string, phaseId uint64, addresses []string, merkleRoot []byte, sender string) *MsgSetAllowlist {
+	return &MsgSetAllowlist{
+		DenomId:    denomId,
+		PhaseId:    phaseId,
+		Addresses:  addresses,
+		MerkleRoot: merkleRoot,
+		Sender:     sender,
+	}
+}
+
+func (msg MsgSetAllowlist) Route() string { return RouterKey }
+
+func (msg MsgSetAllowlist) Type() string { return TypeSetAllowlist }
+
+func (msg MsgSetAllowlist) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if msg.PhaseId == 0 {
+		return sdkerrors.Wrapf(ErrInvalidMintPhase, "phase id must be positive")
+	}
+
+	if len(msg.Addresses) == 0 && len(msg.MerkleRoot) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidMintPhase, "allowlist needs addresses or a merkle root")
+	}
+
+	if len(msg.MerkleRoot) > 0 && len(msg.MerkleRoot) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidMintPhase, "merkle root must be %d bytes", sha256.Size)
+	}
+
+	for _, address := range msg.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlist address %s", err)
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetAllowlist) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeemVoucher(voucher MintVoucher, signature []byte, redeemer string) *MsgRedeemVoucher {
+	return &MsgRedeemVoucher{
+		Voucher:   voucher,
+		Signature: signature,
+		Redeemer:  redeemer,
+	}
+}
+
+func (msg MsgRedeemVoucher) Route() string { return RouterKey }
+
+func (msg MsgRedeemVoucher) Type() string { return TypeRedeemVoucher }
+
+func (msg MsgRedeemVoucher) ValidateBasic() error {
+	if err := msg.Voucher.Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidVoucher, "voucher is not signed")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid redeemer address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRedeemVoucher) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRedeemVoucher) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Redeemer)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgGrantMinter(denomId, minter string, quota uint64, expiresAt *time.Time, sender string) *MsgGrantMinter {
+	return &MsgGrantMinter{
+		DenomId:   denomId,
+		Minter:    minter,
+		Quota:     quota,
+		ExpiresAt: expiresAt,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgGrantMinter) Route() string { return RouterKey }
+
+func (msg MsgGrantMinter) Type() string { return TypeGrantMinter }
+
+func (msg MsgGrantMinter) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Minter == msg.Sender {
+		return sdkerrors.Wrapf(ErrUnauthorized, "the denom creator can already mint")
+	}
+	return nil
+}
+
+func (msg MsgGrantMinter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgGrantMinter) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeMinter(denomId, minter, sender string) *MsgRevokeMinter {
+	return &MsgRevokeMinter{
+		DenomId: denomId,
+		Minter:  minter,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeMinter) Route() string { return RouterKey }
+
+func (msg MsgRevokeMinter) Type() string { return TypeRevokeMinter }
+
+func (msg MsgRevokeMinter) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRevokeMinter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeMinter) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgTransferDenomOwnership(denomId, recipient, sender string) *MsgTransferDenomOwnership {
+	return &MsgTransferDenomOwnership{
+		DenomId:   denomId,
+		Recipient: recipient,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgTransferDenomOwnership) Route() string { return RouterKey }
+
+func (msg MsgTransferDenomOwnership) Type() string { return TypeTransferDenomOwner }
+
+func (msg MsgTransferDenomOwnership) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Recipient == msg.Sender {
+		return sdkerrors.Wrapf(ErrUnauthorized, "%s already owns the denom", msg.Sender)
+	}
+	return nil
+}
+
+func (msg MsgTransferDenomOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTransferDenomOwnership) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptDenomOwnership(denomId, sender string) *MsgAcceptDenomOwnership {
+	return &MsgAcceptDenomOwnership{
+		DenomId: denomId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgAcceptDenomOwnership) Route() string { return RouterKey }
+
+func (msg MsgAcceptDenomOwnership) Type() string { return TypeAcceptDenomOwner }
+
+func (msg MsgAcceptDenomOwnership) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgAcceptDenomOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAcceptDenomOwnership) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgDeleteCommunity(communityId, address string, force bool) *MsgDeleteCommunityRequest {
+	return &MsgDeleteCommunityRequest{
+		CommunityId: communityId,
+		Address:     address,
+		Force:       force,
+	}
+}
+
+func (msg MsgDeleteCommunityRequest) Route() string { return RouterKey }
+
+func (msg MsgDeleteCommunityRequest) Type() string { return TypeDeleteCommunity }
+
+func (msg MsgDeleteCommunityRequest) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "owner address is invalid")
+	}
+	return nil
+}
+
+func (msg MsgDeleteCommunityRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgDeleteCommunityRequest) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgTransferCommunityOwnership(communityId, recipient, sender string) *MsgTransferCommunityOwnership {
+	return &MsgTransferCommunityOwnership{
+		CommunityId: communityId,
+		Recipient:   recipient,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgTransferCommunityOwnership) Route() string { return RouterKey }
+
+func (msg MsgTransferCommunityOwnership) Type() string { return TypeTransferCommunity }
+
+func (msg MsgTransferCommunityOwnership) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Recipient == msg.Sender {
+		return sdkerrors.Wrapf(ErrUnauthorized, "%s already owns the community", msg.Sender)
+	}
+	return nil
+}
+
+func (msg MsgTransferCommunityOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTransferCommunityOwnership) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgGrantCommunityRole(communityId, address string, role CommunityRole, sender string) *MsgGrantCommunityRole {
+	return &MsgGrantCommunityRole{
+		CommunityId: communityId,
+		Address:     address,
+		Role:        role,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgGrantCommunityRole) Route() string { return RouterKey }
+
+func (msg MsgGrantCommunityRole) Type() string { return TypeGrantCommunityRole }
+
+func (msg MsgGrantCommunityRole) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if !msg.Role.IsGrantable() {
+		return sdkerrors.Wrapf(ErrUnauthorized, "role %s cannot be granted", msg.Role)
+	}
+	return nil
+}
+
+func (msg MsgGrantCommunityRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgGrantCommunityRole) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeCommunityRole(communityId, address, sender string) *MsgRevokeCommunityRole {
+	return &MsgRevokeCommunityRole{
+		CommunityId: communityId,
+		Address:     address,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgRevokeCommunityRole) Route() string { return RouterKey }
+
+func (msg MsgRevokeCommunityRole) Type() string { return TypeRevokeCommunityRole }
+
+func (msg MsgRevokeCommunityRole) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRevokeCommunityRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeCommunityRole) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetMembershipPolicy(communityId string, policy MembershipPolicy, gateDenomId, sender string) *MsgSetMembershipPolicy {
+	return &MsgSetMembershipPolicy{
+		CommunityId: communityId,
+		Policy:      policy,
+		GateDenomId: gateDenomId,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgSetMembershipPolicy) Route() string { return RouterKey }
+
+func (msg MsgSetMembershipPolicy) Type() string { return TypeSetMembershipPolicy }
+
+func (msg MsgSetMembershipPolicy) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, ok := MembershipPolicy_name[int32(msg.Policy)]; !ok {
+		return sdkerrors.Wrapf(ErrMembership, "unknown membership policy %d", msg.Policy)
+	}
+
+	if msg.Policy == PolicyTokenGated {
+		if err := ValidateDenomID(msg.GateDenomId); err != nil {
+			return err
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetMembershipPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetMembershipPolicy) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateCommunityInvite(communityId string, codeHash []byte, maxUses uint64, sender string) *MsgCreateCommunityInvite {
+	return &MsgCreateCommunityInvite{
+		CommunityId: communityId,
+		CodeHash:    codeHash,
+		MaxUses:     maxUses,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgCreateCommunityInvite) Route() string { return RouterKey }
+
+func (msg MsgCreateCommunityInvite) Type() string { return TypeCreateCommunityInvite }
+
+func (msg MsgCreateCommunityInvite) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if len(msg.CodeHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrMembership, "invite code hash must be %d bytes", sha256.Size)
+	}
+
+	if msg.MaxUses == 0 {
+		return sdkerrors.Wrapf(ErrMembership, "invite code must have at least one use")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCreateCommunityInvite) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateCommunityInvite) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgApproveJoinRequest(communityId, address string, reject bool, sender string) *MsgApproveJoinRequest {
+	return &MsgApproveJoinRequest{
+		CommunityId: communityId,
+		Address:     address,
+		Reject:      reject,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgApproveJoinRequest) Route() string { return RouterKey }
+
+func (msg MsgApproveJoinRequest) Type() string { return TypeApproveJoinRequest }
+
+func (msg MsgApproveJoinRequest) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgApproveJoinRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgApproveJoinRequest) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgLeaveCommunity(communityId, address string) *MsgLeaveCommunity {
+	return &MsgLeaveCommunity{
+		CommunityId: communityId,
+		Address:     address,
+	}
+}
+
+func (msg MsgLeaveCommunity) Route() string { return RouterKey }
+
+func (msg MsgLeaveCommunity) Type() string { return TypeLeaveCommunity }
+
+func (msg MsgLeaveCommunity) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgLeaveCommunity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgLeaveCommunity) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRemoveMember(communityId, address, sender string) *MsgRemoveMember {
+	return &MsgRemoveMember{
+		CommunityId: communityId,
+		Address:     address,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgRemoveMember) Route() string { return RouterKey }
+
+func (msg MsgRemoveMember) Type() string { return TypeRemoveMember }
+
+func (msg MsgRemoveMember) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRemoveMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRemoveMember) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetTreasuryCut(communityId string, cutBps uint32, sender string) *MsgSetTreasuryCut {
+	return &MsgSetTreasuryCut{
+		CommunityId: communityId,
+		CutBps:      cutBps,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgSetTreasuryCut) Route() string { return RouterKey }
+
+func (msg MsgSetTreasuryCut) Type() string { return TypeSetTreasuryCut }
+
+func (msg MsgSetTreasuryCut) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if msg.CutBps > MaxFeeBps {
+		return sdkerrors.Wrapf(ErrTreasury, "treasury cut %d exceeds %d basis points", msg.CutBps, MaxFeeBps)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetTreasuryCut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetTreasuryCut) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCommunitySpend(communityId, recipient, amount, sender string) *MsgCommunitySpend {
+	return &MsgCommunitySpend{
+		CommunityId: communityId,
+		Recipient:   recipient,
+		Amount:      amount,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgCommunitySpend) Route() string { return RouterKey }
+
+func (msg MsgCommunitySpend) Type() string { return TypeCommunitySpend }
+
+func (msg MsgCommunitySpend) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(msg.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrTreasury, "invalid spend amount %s", err)
+	}
+
+	if !amount.IsAllPositive() {
+		return sdkerrors.Wrapf(ErrTreasury, "spend amount must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgCommunitySpend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCommunitySpend) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetCommunityGovernance(communityId string, votingWeight VotingWeight, votingPeriod time.Duration, quorumBps uint32, sender string) *MsgSetCommunityGovernance {
+	return &MsgSetCommunityGovernance{
+		CommunityId:  communityId,
+		VotingWeight: votingWeight,
+		VotingPeriod: votingPeriod,
+		QuorumBps:    quorumBps,
+		Sender:       sender,
+	}
+}
+
+func (msg MsgSetCommunityGovernance) Route() string { return RouterKey }
+
+func (msg MsgSetCommunityGovernance) Type() string { return TypeSetGovernance }
+
+func (msg MsgSetCommunityGovernance) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, ok := VotingWeight_name[int32(msg.VotingWeight)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "unknown voting weight %d", msg.VotingWeight)
+	}
+
+	if msg.VotingPeriod < 0 {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "voting period cannot be negative")
+	}
+
+	if msg.QuorumBps > MaxFeeBps {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "quorum %d exceeds %d basis points", msg.QuorumBps, MaxFeeBps)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetCommunityGovernance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetCommunityGovernance) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSubmitCommunityProposal(communityId, title, description string, kind ProposalKind, address, amount string, metadata CommunityMetadata, proposer string) *MsgSubmitCommunityProposal {
+	return &MsgSubmitCommunityProposal{
+		CommunityId: communityId,
+		Title:       title,
+		Description: description,
+		Kind:        kind,
+		Address:     address,
+		Amount:      amount,
+		Metadata:    metadata,
+		Proposer:    proposer,
+	}
+}
+
+func (msg MsgSubmitCommunityProposal) Route() string { return RouterKey }
+
+func (msg MsgSubmitCommunityProposal) Type() string { return TypeSubmitProposal }
+
+func (msg MsgSubmitCommunityProposal) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if len(strings.TrimSpace(msg.Title)) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "proposal title cannot be empty")
+	}
+
+	if err := ValidateProposalContent(msg.Kind, msg.Address, msg.Amount); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSubmitCommunityProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSubmitCommunityProposal) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgVoteCommunityProposal(proposalId uint64, option VoteOption, voter string) *MsgVoteCommunityProposal {
+	return &MsgVoteCommunityProposal{
+		ProposalId: proposalId,
+		Option:     option,
+		Voter:      voter,
+	}
+}
+
+func (msg MsgVoteCommunityProposal) Route() string { return RouterKey }
+
+func (msg MsgVoteCommunityProposal) Type() string { return TypeVoteProposal }
+
+func (msg MsgVoteCommunityProposal) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrapf(ErrUnknownProposal, "invalid proposal id")
+	}
+
+	if msg.Option != VoteYes && msg.Option != VoteNo && msg.Option != VoteAbstain {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "unknown vote option %s", msg.Option)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgVoteCommunityProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgVoteCommunityProposal) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateBadgeDenom(id, name, symbol, description, previewURI, communityId, data, creator string) *MsgCreateBadgeDenom {
+	return &MsgCreateBadgeDenom{
+		Id:          id,
+		Name:        name,
+		Symbol:      symbol,
+		Description: description,
+		PreviewURI:  previewURI,
+		CommunityId: communityId,
+		Data:        data,
+		Creator:     creator,
+	}
+}
+
+func (msg MsgCreateBadgeDenom) Route() string { return RouterKey }
+
+func (msg MsgCreateBadgeDenom) Type() string { return TypeCreateBadgeDenom }
+
+func (msg MsgCreateBadgeDenom) ValidateBasic() error {
+	if err := ValidateDenomID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomSymbol(msg.Symbol); err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(msg.Name)
+	if len(name) > 0 && !utf8.ValidString(name) {
+		return sdkerrors.Wrap(ErrInvalidDenom, "denom name is invalid")
+	}
+
+	if len(strings.TrimSpace(msg.CommunityId)) == 0 {
+		return sdkerrors.Wrapf(ErrCommunityNotFound, "invalid community id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgCreateBadgeDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateBadgeDenom) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgIssueBadge(denomId, data string, metadata Metadata, recipient, sender string) *MsgIssueBadge {
+	return &MsgIssueBadge{
+		Id:        GenUniqueID(NFTPrefix),
+		DenomId:   denomId,
+		Metadata:  metadata,
+		Data:      data,
+		Recipient: recipient,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgIssueBadge) Route() string { return RouterKey }
+
+func (msg MsgIssueBadge) Type() string { return TypeIssueBadge }
+
+func (msg MsgIssueBadge) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(msg.Metadata.Name)
+	if len(name) > 0 && !utf8.ValidString(name) {
+		return sdkerrors.Wrap(ErrInvalidBadge, "name is invalid")
+	}
+
+	if err := ValidateMediaURI(msg.Metadata.MediaURI); err != nil {
+		return err
+	}
+
+	if err := ValidatePreviewURI(msg.Metadata.PreviewURI); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgIssueBadge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgIssueBadge) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeBadge(id, denomId, reason, sender string) *MsgRevokeBadge {
+	return &MsgRevokeBadge{
+		Id:      id,
+		DenomId: denomId,
+		Reason:  reason,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeBadge) Route() string { return RouterKey }
+
+func (msg MsgRevokeBadge) Type() string { return TypeRevokeBadge }
+
+func (msg MsgRevokeBadge) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRevokeBadge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeBadge) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgApproveNFT(id, denomId, spender, sender string) *MsgApproveNFT {
+	return &MsgApproveNFT{
+		Id:      id,
+		DenomId: denomId,
+		Spender: spender,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgApproveNFT) Route() string { return RouterKey }
+
+func (msg MsgApproveNFT) Type() string { return TypeApproveNFT }
+
+func (msg MsgApproveNFT) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Spender == msg.Sender {
+		return sdkerrors.Wrapf(ErrUnauthorized, "cannot approve the sender")
+	}
+	return nil
+}
+
+func (msg MsgApproveNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgApproveNFT) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeNFTApproval(id, denomId, sender string) *MsgRevokeNFTApproval {
+	return &MsgRevokeNFTApproval{
+		Id:      id,
+		DenomId: denomId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeNFTApproval) Route() string { return RouterKey }
+
+func (msg MsgRevokeNFTApproval) Type() string { return TypeRevokeNFTApproval }
+
+func (msg MsgRevokeNFTApproval) ValidateBasic() error {
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRevokeNFTApproval) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeNFTApproval) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgApproveOperator(operator, denomId, sender string) *MsgApproveOperator {
+	return &MsgApproveOperator{
+		Operator: operator,
+		DenomId:  denomId,
+		Sender:   sender,
+	}
+}
+
+func (msg MsgApproveOperator) Route() string { return RouterKey }
+
+func (msg MsgApproveOperator) Type() string { return TypeApproveOperator }
+
+func (msg MsgApproveOperator) ValidateBasic() error {
+	return ValidateOperatorApproval(OperatorApproval{Owner: msg.Sender, Operator: msg.Operator, DenomId: msg.DenomId})
+}
+
+func (msg MsgApproveOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgApproveOperator) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeOperator(operator, denomId, sender string) *MsgRevokeOperator {
+	return &MsgRevokeOperator{
+		Operator: operator,
+		DenomId:  denomId,
+		Sender:   sender,
+	}
+}
+
+func (msg MsgRevokeOperator) Route() string { return RouterKey }
+
+func (msg MsgRevokeOperator) Type() string { return TypeRevokeOperator }
+
+func (msg MsgRevokeOperator) ValidateBasic() error {
+	return ValidateOperatorApproval(OperatorApproval{Owner: msg.Sender, Operator: msg.Operator, DenomId: msg.DenomId})
+}
+
+func (msg MsgRevokeOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeOperator) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{from}
+}
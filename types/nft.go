@@ -64,6 +64,12 @@ func (nft NFT) GetRoyalties() string {
 	return nft.Royalties
 }
 
+// GetRoyaltyShares returns the royalty recipients of the nft. Nfts without their own recipients
+// pay the royalties of their denom.
+func (nft NFT) GetRoyaltyShares() []RoyaltyShare {
+	return nft.RoyaltyShares
+}
+
 func (nft NFT) GetCreator() sdk.AccAddress {
 	creator, _ := sdk.AccAddressFromBech32(nft.Creator)
 	return creator
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -27,6 +28,35 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GateAction is what happens to the gating nfts when a minter uses them
+type GateAction int32
+
+const (
+	GateHold GateAction = 0
+	GateBurn GateAction = 1
+	GateLock GateAction = 2
+)
+
+var GateAction_name = map[int32]string{
+	0: "GATE_ACTION_HOLD",
+	1: "GATE_ACTION_BURN",
+	2: "GATE_ACTION_LOCK",
+}
+
+var GateAction_value = map[string]int32{
+	"GATE_ACTION_HOLD": 0,
+	"GATE_ACTION_BURN": 1,
+	"GATE_ACTION_LOCK": 2,
+}
+
+func (x GateAction) String() string {
+	return proto.EnumName(GateAction_name, int32(x))
+}
+
+func (GateAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{0}
+}
+
 type Collection struct {
 	Denom Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
 	NFTs  []NFT `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
@@ -118,6 +148,12 @@ type Denom struct {
 	AvailableNfts   int64       `protobuf:"varint,12,opt,name=available_nfts,json=availableNfts,proto3" json:"available_nfts,omitempty"`
 	Data            string      `protobuf:"bytes,13,opt,name=data,proto3" json:"data,omitempty"`
 	PaymentInfo     PaymentInfo `protobuf:"bytes,14,opt,name=payment_info,json=paymentInfo,proto3" json:"payment_info"`
+	// royalty_shares are the default royalties of the nfts in the denom
+	RoyaltyShares []RoyaltyShare `protobuf:"bytes,15,rep,name=royalty_shares,json=royaltyShares,proto3" json:"royalty_shares" yaml:"royalty_shares"`
+	// token_gate is the holding of every dependent denom required to mint in the denom
+	TokenGate TokenGate `protobuf:"bytes,16,opt,name=token_gate,json=tokenGate,proto3" json:"token_gate" yaml:"token_gate"`
+	// badge denoms hold soulbound nfts issued by the admins of the community
+	Badge bool `protobuf:"varint,17,opt,name=badge,proto3" json:"badge,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -203,6 +239,8 @@ type NFT struct {
 	Listed       bool      `protobuf:"varint,7,opt,name=listed,proto3" json:"listed,omitempty"`
 	CreatedAt    time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
 	Data         string    `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// royalty_shares override the default royalties of the denom
+	RoyaltyShares []RoyaltyShare `protobuf:"bytes,10,rep,name=royalty_shares,json=royaltyShares,proto3" json:"royalty_shares" yaml:"royalty_shares"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
@@ -315,7 +353,291 @@ func (m *PaymentInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PaymentInfo proto.InternalMessageInfo
 
+// RoyaltyShare is the part of every sale price paid to a royalty recipient
+type RoyaltyShare struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Share   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+}
+
+func (m *RoyaltyShare) Reset()         { *m = RoyaltyShare{} }
+func (m *RoyaltyShare) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyShare) ProtoMessage()    {}
+func (*RoyaltyShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{7}
+}
+func (m *RoyaltyShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyShare.Merge(m, src)
+}
+func (m *RoyaltyShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyShare proto.InternalMessageInfo
+
+// TokenGate requires minters to hold nfts of the dependent denoms of a denom
+type TokenGate struct {
+	// min_holding is the number of nfts of every dependent denom a minter must hold. Zero requires one.
+	MinHolding uint64     `protobuf:"varint,1,opt,name=min_holding,json=minHolding,proto3" json:"min_holding,omitempty" yaml:"min_holding"`
+	Action     GateAction `protobuf:"varint,2,opt,name=action,proto3,enum=nft.v1beta1.GateAction" json:"action,omitempty"`
+}
+
+func (m *TokenGate) Reset()         { *m = TokenGate{} }
+func (m *TokenGate) String() string { return proto.CompactTextString(m) }
+func (*TokenGate) ProtoMessage()    {}
+func (*TokenGate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{8}
+}
+func (m *TokenGate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenGate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenGate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenGate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenGate.Merge(m, src)
+}
+func (m *TokenGate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenGate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenGate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenGate proto.InternalMessageInfo
+
+// LockedGateToken is an nft that was used to mint in a token gated denom and
+// cannot be used for that denom again
+type LockedGateToken struct {
+	DenomId          string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	DependentDenomId string `protobuf:"bytes,2,opt,name=dependent_denom_id,json=dependentDenomId,proto3" json:"dependent_denom_id,omitempty" yaml:"dependent_denom_id"`
+	NftId            string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty" yaml:"nft_id"`
+}
+
+func (m *LockedGateToken) Reset()         { *m = LockedGateToken{} }
+func (m *LockedGateToken) String() string { return proto.CompactTextString(m) }
+func (*LockedGateToken) ProtoMessage()    {}
+func (*LockedGateToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{9}
+}
+func (m *LockedGateToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedGateToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedGateToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedGateToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedGateToken.Merge(m, src)
+}
+func (m *LockedGateToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedGateToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedGateToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedGateToken proto.InternalMessageInfo
+
+// DenomOwnershipTransfer is a proposed change of the owner of a denom waiting
+// for the recipient to accept it
+type DenomOwnershipTransfer struct {
+	DenomId   string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *DenomOwnershipTransfer) Reset()         { *m = DenomOwnershipTransfer{} }
+func (m *DenomOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*DenomOwnershipTransfer) ProtoMessage()    {}
+func (*DenomOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{10}
+}
+func (m *DenomOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomOwnershipTransfer.Merge(m, src)
+}
+func (m *DenomOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomOwnershipTransfer proto.InternalMessageInfo
+
+// Badge is a soulbound nft of a badge denom with the community it belongs to
+type Badge struct {
+	CommunityId string `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	DenomId     string `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	NFT         NFT    `protobuf:"bytes,3,opt,name=nft,proto3" json:"nft"`
+}
+
+func (m *Badge) Reset()         { *m = Badge{} }
+func (m *Badge) String() string { return proto.CompactTextString(m) }
+func (*Badge) ProtoMessage()    {}
+func (*Badge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{11}
+}
+func (m *Badge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Badge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Badge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Badge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Badge.Merge(m, src)
+}
+func (m *Badge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Badge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Badge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Badge proto.InternalMessageInfo
+
+// NFTApproval allows the spender to transfer, sell or burn a single nft for its owner.
+// It is cleared when the nft changes hands.
+type NFTApproval struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty" yaml:"nft_id"`
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+}
+
+func (m *NFTApproval) Reset()         { *m = NFTApproval{} }
+func (m *NFTApproval) String() string { return proto.CompactTextString(m) }
+func (*NFTApproval) ProtoMessage()    {}
+func (*NFTApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{12}
+}
+func (m *NFTApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTApproval.Merge(m, src)
+}
+func (m *NFTApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTApproval proto.InternalMessageInfo
+
+// OperatorApproval allows the operator to transfer, sell or burn every nft of the owner in a denom,
+// or in every denom when denom_id is empty
+type OperatorApproval struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	DenomId  string `protobuf:"bytes,3,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+}
+
+func (m *OperatorApproval) Reset()         { *m = OperatorApproval{} }
+func (m *OperatorApproval) String() string { return proto.CompactTextString(m) }
+func (*OperatorApproval) ProtoMessage()    {}
+func (*OperatorApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b849e9a6361278a, []int{13}
+}
+func (m *OperatorApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorApproval.Merge(m, src)
+}
+func (m *OperatorApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorApproval proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("nft.v1beta1.GateAction", GateAction_name, GateAction_value)
 	proto.RegisterType((*Collection)(nil), "nft.v1beta1.Collection")
 	proto.RegisterType((*IDCollection)(nil), "nft.v1beta1.IDCollection")
 	proto.RegisterType((*Denom)(nil), "nft.v1beta1.Denom")
@@ -323,68 +645,105 @@ func init() {
 	proto.RegisterType((*NFT)(nil), "nft.v1beta1.NFT")
 	proto.RegisterType((*Owner)(nil), "nft.v1beta1.Owner")
 	proto.RegisterType((*PaymentInfo)(nil), "nft.v1beta1.PaymentInfo")
+	proto.RegisterType((*RoyaltyShare)(nil), "nft.v1beta1.RoyaltyShare")
+	proto.RegisterType((*TokenGate)(nil), "nft.v1beta1.TokenGate")
+	proto.RegisterType((*LockedGateToken)(nil), "nft.v1beta1.LockedGateToken")
+	proto.RegisterType((*DenomOwnershipTransfer)(nil), "nft.v1beta1.DenomOwnershipTransfer")
+	proto.RegisterType((*Badge)(nil), "nft.v1beta1.Badge")
+	proto.RegisterType((*NFTApproval)(nil), "nft.v1beta1.NFTApproval")
+	proto.RegisterType((*OperatorApproval)(nil), "nft.v1beta1.OperatorApproval")
 }
 
 func init() { proto.RegisterFile("nft/v1beta1/nft.proto", fileDescriptor_7b849e9a6361278a) }
 
 var fileDescriptor_7b849e9a6361278a = []byte{
-	// 892 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x8e, 0xe3, 0x44,
-	0x10, 0x8e, 0xf3, 0x9f, 0x72, 0x26, 0x3b, 0x34, 0xbb, 0x2b, 0x33, 0x62, 0xe3, 0x60, 0x0d, 0xd2,
-	0x20, 0xa4, 0x44, 0x1b, 0x0e, 0x48, 0x2b, 0x71, 0x98, 0xb0, 0x8c, 0x94, 0xc3, 0x86, 0x95, 0xc9,
-	0x4a, 0x88, 0x4b, 0xd4, 0x71, 0xb7, 0x43, 0x0b, 0xdb, 0x6d, 0xba, 0x3b, 0x33, 0xf2, 0x53, 0xb0,
-	0x8f, 0xc0, 0xd3, 0xac, 0xe6, 0xb8, 0x47, 0x4e, 0x01, 0x32, 0x17, 0x8e, 0x28, 0x4f, 0x80, 0xdc,
-	0x6d, 0x67, 0x92, 0x9d, 0x1b, 0xb7, 0xaa, 0xaf, 0xbe, 0xb8, 0xbb, 0xea, 0xfb, 0xba, 0x02, 0x4f,
-	0x92, 0x50, 0x8d, 0xae, 0x9f, 0x2f, 0xa9, 0xc2, 0xcf, 0x47, 0x49, 0xa8, 0x86, 0xa9, 0xe0, 0x8a,
-	0x23, 0x3b, 0x0f, 0x0b, 0xf8, 0xcc, 0x5d, 0x71, 0xbe, 0x8a, 0xe8, 0x48, 0x97, 0x96, 0xeb, 0x70,
-	0xa4, 0x58, 0x4c, 0xa5, 0xc2, 0x71, 0x6a, 0xd8, 0x67, 0x8f, 0x57, 0x7c, 0xc5, 0x75, 0x38, 0xca,
-	0x23, 0x83, 0x7a, 0x29, 0xc0, 0xb7, 0x3c, 0x8a, 0x68, 0xa0, 0x18, 0x4f, 0xd0, 0x10, 0x1a, 0x84,
-	0x26, 0x3c, 0x76, 0xac, 0x81, 0x75, 0x61, 0x8f, 0xd1, 0xf0, 0xe0, 0x84, 0xe1, 0xcb, 0xbc, 0x32,
-	0xa9, 0xdf, 0x6e, 0xdc, 0x8a, 0x6f, 0x68, 0x68, 0x0c, 0xf5, 0x24, 0x54, 0xd2, 0xa9, 0x0e, 0x6a,
-	0x17, 0xf6, 0xf8, 0xf4, 0x88, 0x3e, 0xbb, 0x9a, 0x4f, 0xba, 0x39, 0x79, 0xbb, 0x71, 0xeb, 0xb3,
-	0xab, 0xb9, 0xf4, 0x35, 0xd7, 0xfb, 0x15, 0xba, 0xd3, 0x97, 0x47, 0x67, 0xb6, 0xf5, 0xc7, 0x16,
-	0x8c, 0xe8, 0x63, 0x3b, 0x93, 0x8f, 0x77, 0x1b, 0xf7, 0x51, 0x86, 0xe3, 0xe8, 0x85, 0x57, 0x56,
-	0x3c, 0xbf, 0xa5, 0xc3, 0x29, 0x41, 0x5f, 0x42, 0x2b, 0x09, 0xd5, 0x82, 0x11, 0x73, 0x6c, 0x67,
-	0x82, 0x76, 0x1b, 0xb7, 0x67, 0xe8, 0x45, 0xc1, 0xf3, 0x9b, 0x49, 0xa8, 0xa6, 0x44, 0xbe, 0xa8,
-	0xff, 0xf3, 0xbb, 0x6b, 0x79, 0xff, 0xd6, 0xa0, 0xa1, 0x6f, 0x8f, 0x7a, 0x50, 0x2d, 0x8f, 0xf1,
-	0xab, 0x8c, 0x20, 0x04, 0xf5, 0x04, 0xc7, 0xd4, 0xa9, 0x6a, 0x44, 0xc7, 0xe8, 0x29, 0x34, 0x65,
-	0x16, 0x2f, 0x79, 0xe4, 0xd4, 0x34, 0x5a, 0x64, 0xc8, 0x81, 0x56, 0x20, 0x28, 0x56, 0x5c, 0x38,
-	0x75, 0x5d, 0x28, 0x53, 0x34, 0x00, 0x9b, 0x50, 0x19, 0x08, 0x96, 0xe6, 0x1d, 0x39, 0x0d, 0x5d,
-	0x3d, 0x84, 0xd0, 0x77, 0x60, 0xa7, 0x82, 0x5e, 0x33, 0x7a, 0xb3, 0x58, 0x0b, 0xe6, 0x34, 0x75,
-	0x9f, 0xe7, 0xdb, 0x8d, 0x0b, 0xaf, 0x0d, 0xfc, 0xc6, 0x9f, 0xee, 0x36, 0x2e, 0x32, 0x6d, 0x1c,
-	0x50, 0x3d, 0x1f, 0x8a, 0xec, 0x8d, 0x60, 0xe8, 0x0b, 0x38, 0x25, 0x34, 0xa5, 0x09, 0xa1, 0x89,
-	0x5a, 0xe8, 0x81, 0x48, 0xa7, 0x95, 0x0f, 0xc1, 0x7f, 0xb4, 0xc7, 0x75, 0xa3, 0x12, 0x7d, 0x06,
-	0xdd, 0x80, 0xc7, 0xf1, 0x3a, 0x61, 0x2a, 0xcb, 0x47, 0xdb, 0x36, 0x97, 0xda, 0x63, 0x53, 0x82,
-	0xce, 0xa0, 0x1d, 0x60, 0x45, 0x57, 0x5c, 0x64, 0x4e, 0x47, 0x97, 0xf7, 0x79, 0xfe, 0xf3, 0x54,
-	0xb0, 0x18, 0x8b, 0x6c, 0x21, 0x71, 0x44, 0x1d, 0x18, 0x58, 0x17, 0x6d, 0xdf, 0x2e, 0xb0, 0x1f,
-	0x70, 0x44, 0xd1, 0x33, 0x00, 0xc5, 0x15, 0x8e, 0x16, 0xda, 0x02, 0xf6, 0xc0, 0xba, 0xa8, 0xf9,
-	0x1d, 0x8d, 0xcc, 0x42, 0x25, 0xd1, 0xe7, 0xd0, 0xc3, 0xd7, 0x98, 0x45, 0x78, 0x19, 0x51, 0x43,
-	0xe9, 0x6a, 0xca, 0xc9, 0x1e, 0xd5, 0x34, 0x04, 0x75, 0x82, 0x15, 0x76, 0x4e, 0x8c, 0x02, 0x79,
-	0x8c, 0x2e, 0xa1, 0x9b, 0xe2, 0x2c, 0xce, 0x9b, 0x64, 0x49, 0xc8, 0x9d, 0x9e, 0x76, 0xa3, 0x73,
-	0x64, 0xaf, 0xd7, 0x86, 0x30, 0x4d, 0x42, 0x5e, 0x78, 0xd2, 0x4e, 0xef, 0x21, 0xef, 0x9d, 0x05,
-	0xed, 0x57, 0x54, 0x61, 0xfd, 0xbd, 0x52, 0x65, 0xeb, 0x40, 0xe5, 0x0f, 0x34, 0xab, 0x3e, 0xd4,
-	0xec, 0x1b, 0xe8, 0xc4, 0x94, 0x30, 0xac, 0x15, 0xd3, 0x56, 0x98, 0x0c, 0xb6, 0x1b, 0xb7, 0xfd,
-	0x2a, 0x07, 0x8d, 0x5e, 0xa7, 0x46, 0xaf, 0x3d, 0xcd, 0xf3, 0xdb, 0x3a, 0xce, 0xb5, 0xfa, 0x40,
-	0xf2, 0xfa, 0xff, 0x93, 0xdc, 0x7b, 0x57, 0x85, 0xda, 0xec, 0x6a, 0xfe, 0xc0, 0xb9, 0x5f, 0x43,
-	0x3b, 0x2e, 0xfa, 0xd3, 0x97, 0xb7, 0xc7, 0x4f, 0x8e, 0xe6, 0x53, 0x36, 0x5f, 0x0c, 0x67, 0x4f,
-	0x46, 0x8f, 0xa1, 0xc1, 0x6f, 0x12, 0x2a, 0x0a, 0x77, 0x9b, 0x04, 0x79, 0xd0, 0x55, 0x02, 0x27,
-	0x32, 0xa4, 0x22, 0x97, 0x46, 0x5f, 0xb7, 0xed, 0x1f, 0x61, 0xe8, 0x53, 0xe8, 0x08, 0x9e, 0xe1,
-	0x48, 0x31, 0x2a, 0x0b, 0x93, 0xdf, 0x03, 0x87, 0xcf, 0xa3, 0x79, 0xfc, 0x3c, 0x9e, 0x42, 0x33,
-	0x62, 0x52, 0x51, 0xe2, 0xb4, 0xf4, 0x57, 0x8b, 0x0c, 0xfd, 0x08, 0xa0, 0x29, 0x94, 0x2c, 0xb0,
-	0xd2, 0x06, 0xb5, 0xc7, 0x67, 0x43, 0xb3, 0xc7, 0x86, 0xe5, 0x1e, 0x1b, 0xce, 0xcb, 0x3d, 0x36,
-	0x79, 0x96, 0x77, 0xb2, 0xdb, 0xb8, 0x1f, 0x99, 0x91, 0xdd, 0xff, 0xd6, 0x7b, 0xfb, 0xa7, 0x6b,
-	0xf9, 0x9d, 0x02, 0xb8, 0x54, 0x7b, 0x53, 0x75, 0xee, 0x4d, 0xe5, 0xfd, 0x66, 0x41, 0xe3, 0x7b,
-	0xdd, 0xab, 0x03, 0x2d, 0x4c, 0x88, 0xa0, 0x52, 0x16, 0xf3, 0x2c, 0x53, 0x14, 0x42, 0x8f, 0x91,
-	0x45, 0xb0, 0x5f, 0x4e, 0xe5, 0x66, 0xfb, 0xe4, 0x68, 0xb4, 0x87, 0xeb, 0x6b, 0x72, 0x5e, 0xac,
-	0xb8, 0x93, 0x43, 0x54, 0xee, 0x36, 0xae, 0x6d, 0x6e, 0xc9, 0x48, 0x20, 0x3d, 0xff, 0x84, 0x91,
-	0x83, 0x6a, 0xb1, 0x96, 0x96, 0x60, 0x1f, 0xb8, 0x18, 0xb9, 0x60, 0xe3, 0x20, 0xa0, 0x52, 0x2e,
-	0x54, 0x96, 0x96, 0x66, 0x05, 0x03, 0xcd, 0xb3, 0x54, 0x2f, 0x26, 0x1c, 0xf3, 0x75, 0xa2, 0xb4,
-	0xe0, 0x35, 0xbf, 0xc8, 0xf4, 0x3b, 0x5e, 0x0b, 0x41, 0x93, 0x20, 0x2b, 0x44, 0xdd, 0xe7, 0x93,
-	0xc9, 0xed, 0xdf, 0xfd, 0xca, 0xed, 0xb6, 0x6f, 0xbd, 0xdf, 0xf6, 0xad, 0xbf, 0xb6, 0x7d, 0xeb,
-	0xed, 0x5d, 0xbf, 0xf2, 0xfe, 0xae, 0x5f, 0xf9, 0xe3, 0xae, 0x5f, 0xf9, 0xe9, 0x7c, 0xc5, 0xd4,
-	0xcf, 0xeb, 0xe5, 0x30, 0xe0, 0xf1, 0xe8, 0x72, 0xad, 0x78, 0xc2, 0xe3, 0x6c, 0x46, 0xd5, 0x0d,
-	0x17, 0xbf, 0xe4, 0xff, 0x33, 0xa3, 0xfc, 0x22, 0x72, 0xd9, 0xd4, 0x5a, 0x7c, 0xf5, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xea, 0x11, 0xb2, 0xbc, 0x87, 0x06, 0x00, 0x00,
+	// 1368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0xf5, 0xcf, 0xd2, 0x50, 0xb6, 0x95, 0x4d, 0xe2, 0x30, 0xc6, 0x67, 0xd1, 0x1f, 0x91,
+	0xef, 0x83, 0xdb, 0xa2, 0x12, 0xe2, 0x1e, 0x02, 0x04, 0xe8, 0xc1, 0x8c, 0xe3, 0x44, 0x48, 0x22,
+	0x07, 0x8c, 0x02, 0x14, 0xbd, 0x10, 0x2b, 0x72, 0x25, 0x13, 0x16, 0xb9, 0x2c, 0xb9, 0x72, 0x20,
+	0x14, 0x08, 0xd0, 0x5b, 0xd1, 0x4b, 0xf3, 0x02, 0x05, 0x0a, 0xb4, 0x40, 0x5f, 0xa1, 0x7d, 0x81,
+	0x22, 0xc7, 0x1c, 0x8b, 0x1e, 0xd4, 0xd6, 0xb9, 0xf4, 0xac, 0x27, 0x28, 0xf6, 0x8f, 0x24, 0xd2,
+	0x2e, 0xd2, 0xa6, 0xe8, 0x89, 0x3b, 0x33, 0xbf, 0xdd, 0x9d, 0x99, 0xfd, 0xcd, 0x0c, 0xe1, 0x6a,
+	0x34, 0x60, 0xed, 0xd3, 0x9b, 0x7d, 0xc2, 0xf0, 0xcd, 0x76, 0x34, 0x60, 0xad, 0x38, 0xa1, 0x8c,
+	0x22, 0x9d, 0x2f, 0x95, 0x7a, 0xcb, 0x1c, 0x52, 0x3a, 0x1c, 0x91, 0xb6, 0x30, 0xf5, 0xc7, 0x83,
+	0x36, 0x0b, 0x42, 0x92, 0x32, 0x1c, 0xc6, 0x12, 0xbd, 0x75, 0x65, 0x48, 0x87, 0x54, 0x2c, 0xdb,
+	0x7c, 0x25, 0xb5, 0x56, 0x0c, 0x70, 0x87, 0x8e, 0x46, 0xc4, 0x63, 0x01, 0x8d, 0x50, 0x0b, 0xca,
+	0x3e, 0x89, 0x68, 0x68, 0x68, 0x3b, 0xda, 0xae, 0xbe, 0x87, 0x5a, 0x99, 0x1b, 0x5a, 0x07, 0xdc,
+	0x62, 0x97, 0x5e, 0x4e, 0xcd, 0x15, 0x47, 0xc2, 0xd0, 0x1e, 0x94, 0xa2, 0x01, 0x4b, 0x8d, 0xc2,
+	0x4e, 0x71, 0x57, 0xdf, 0x6b, 0xe4, 0xe0, 0xdd, 0xc3, 0x9e, 0x5d, 0xe7, 0xe0, 0xb3, 0xa9, 0x59,
+	0xea, 0x1e, 0xf6, 0x52, 0x47, 0x60, 0xad, 0x4f, 0xa0, 0xde, 0x39, 0xc8, 0xdd, 0x59, 0x15, 0x87,
+	0xb9, 0x81, 0x2f, 0xae, 0xad, 0xd9, 0x97, 0x67, 0x53, 0x73, 0x63, 0x82, 0xc3, 0xd1, 0x6d, 0x6b,
+	0x6e, 0xb1, 0x9c, 0x55, 0xb1, 0xec, 0xf8, 0xe8, 0x3d, 0x58, 0x8d, 0x06, 0xcc, 0x0d, 0x7c, 0x79,
+	0x6d, 0xcd, 0x46, 0xb3, 0xa9, 0xb9, 0x2e, 0xe1, 0xca, 0x60, 0x39, 0x95, 0x68, 0xc0, 0x3a, 0x7e,
+	0x7a, 0xbb, 0xf4, 0xfb, 0xd7, 0xa6, 0x66, 0xfd, 0x50, 0x86, 0xb2, 0xf0, 0x1e, 0xad, 0x43, 0x61,
+	0x7e, 0x8d, 0x53, 0x08, 0x7c, 0x84, 0xa0, 0x14, 0xe1, 0x90, 0x18, 0x05, 0xa1, 0x11, 0x6b, 0xb4,
+	0x09, 0x95, 0x74, 0x12, 0xf6, 0xe9, 0xc8, 0x28, 0x0a, 0xad, 0x92, 0x90, 0x01, 0xab, 0x5e, 0x42,
+	0x30, 0xa3, 0x89, 0x51, 0x12, 0x86, 0xb9, 0x88, 0x76, 0x40, 0xf7, 0x49, 0xea, 0x25, 0x41, 0xcc,
+	0x23, 0x32, 0xca, 0xc2, 0x9a, 0x55, 0xa1, 0xbb, 0xa0, 0xc7, 0x09, 0x39, 0x0d, 0xc8, 0x33, 0x77,
+	0x9c, 0x04, 0x46, 0x45, 0xc4, 0x79, 0xe3, 0x6c, 0x6a, 0xc2, 0x63, 0xa9, 0x7e, 0xea, 0x74, 0x66,
+	0x53, 0x13, 0xc9, 0x30, 0x32, 0x50, 0xcb, 0x01, 0x25, 0x3d, 0x4d, 0x02, 0xf4, 0x0e, 0x34, 0x7c,
+	0x12, 0x93, 0xc8, 0x27, 0x11, 0x73, 0x45, 0x42, 0x52, 0x63, 0x95, 0x27, 0xc1, 0xd9, 0x58, 0xe8,
+	0x45, 0xa0, 0x29, 0xfa, 0x2f, 0xd4, 0x3d, 0x1a, 0x86, 0xe3, 0x28, 0x60, 0x13, 0x9e, 0xda, 0xaa,
+	0x74, 0x6a, 0xa1, 0xeb, 0xf8, 0x68, 0x0b, 0xaa, 0x1e, 0x66, 0x64, 0x48, 0x93, 0x89, 0x51, 0x13,
+	0xe6, 0x85, 0xcc, 0xb7, 0xc7, 0x49, 0x10, 0xe2, 0x64, 0xe2, 0xa6, 0x78, 0x44, 0x0c, 0xd8, 0xd1,
+	0x76, 0xab, 0x8e, 0xae, 0x74, 0x4f, 0xf0, 0x88, 0xa0, 0x6d, 0x00, 0x46, 0x19, 0x1e, 0xb9, 0x82,
+	0x02, 0xfa, 0x8e, 0xb6, 0x5b, 0x74, 0x6a, 0x42, 0xd3, 0x1d, 0xb0, 0x14, 0xfd, 0x0f, 0xd6, 0xf1,
+	0x29, 0x0e, 0x46, 0xb8, 0x3f, 0x22, 0x12, 0x52, 0x17, 0x90, 0xb5, 0x85, 0x56, 0xc0, 0x10, 0x94,
+	0x7c, 0xcc, 0xb0, 0xb1, 0x26, 0x5f, 0x80, 0xaf, 0xd1, 0x3e, 0xd4, 0x63, 0x3c, 0x09, 0x79, 0x90,
+	0x41, 0x34, 0xa0, 0xc6, 0xba, 0x60, 0xa3, 0x91, 0xa3, 0xd7, 0x63, 0x09, 0xe8, 0x44, 0x03, 0xaa,
+	0x38, 0xa9, 0xc7, 0x4b, 0x15, 0x72, 0x61, 0x3d, 0xa1, 0x13, 0x3c, 0x62, 0x13, 0x37, 0x3d, 0xc6,
+	0x09, 0x49, 0x8d, 0x0d, 0xc1, 0xd1, 0xeb, 0xb9, 0x43, 0x1c, 0x09, 0x79, 0xc2, 0x11, 0xf6, 0x36,
+	0x3f, 0x65, 0x36, 0x35, 0xaf, 0xca, 0x47, 0xc8, 0x6f, 0xb7, 0x9c, 0xb5, 0x24, 0x03, 0x4e, 0xd1,
+	0x63, 0x1e, 0xfd, 0x09, 0x89, 0xdc, 0x21, 0x66, 0xc4, 0x68, 0x08, 0x0f, 0x37, 0x73, 0x87, 0xf7,
+	0xb8, 0xf9, 0x1e, 0x66, 0xc4, 0xbe, 0xae, 0x4e, 0xbe, 0x24, 0x4f, 0x5e, 0xee, 0xb3, 0x78, 0xc2,
+	0x14, 0x0a, 0x5d, 0x81, 0x72, 0x1f, 0xfb, 0x43, 0x62, 0x5c, 0x12, 0xb9, 0x96, 0x82, 0xf5, 0xa3,
+	0x06, 0xd5, 0x47, 0x84, 0x61, 0x91, 0x98, 0x39, 0x5d, 0xb5, 0x0c, 0x5d, 0xcf, 0x91, 0xaf, 0x70,
+	0x91, 0x7c, 0x1f, 0x42, 0x2d, 0x24, 0x7e, 0x80, 0x05, 0xf5, 0x04, 0xa7, 0xed, 0x9d, 0xb3, 0xa9,
+	0x59, 0x7d, 0xc4, 0x95, 0x92, 0x78, 0x0d, 0xe9, 0xd9, 0x02, 0x66, 0x39, 0x55, 0xb1, 0xe6, 0xa4,
+	0x3b, 0xc7, 0xdd, 0xd2, 0x3f, 0xe3, 0xae, 0xf5, 0x5d, 0x11, 0x8a, 0xdd, 0xc3, 0xde, 0x85, 0x12,
+	0xbc, 0x05, 0xd5, 0x50, 0xc5, 0x27, 0x9c, 0xd7, 0xf7, 0xae, 0xe6, 0xd2, 0x38, 0x0f, 0x5e, 0xbd,
+	0xf2, 0x02, 0xcc, 0xf3, 0x45, 0x9f, 0x45, 0x24, 0x51, 0x65, 0x2a, 0x05, 0x64, 0x41, 0x9d, 0x25,
+	0x38, 0x4a, 0x07, 0x24, 0xe1, 0x1c, 0x13, 0xee, 0x56, 0x9d, 0x9c, 0x0e, 0xfd, 0x07, 0x6a, 0xf2,
+	0x31, 0x03, 0x92, 0xaa, 0x6a, 0x5d, 0x2a, 0xb2, 0x75, 0x5e, 0xc9, 0xd7, 0xf9, 0x26, 0x54, 0x46,
+	0x41, 0xca, 0x88, 0x6f, 0xac, 0x8a, 0x53, 0x95, 0x84, 0x3e, 0x02, 0x10, 0x10, 0xe2, 0xbb, 0x98,
+	0x89, 0x4a, 0xd3, 0xf7, 0xb6, 0x5a, 0xb2, 0x21, 0xb7, 0xe6, 0x0d, 0xb9, 0xd5, 0x9b, 0x37, 0x64,
+	0x7b, 0x3b, 0xcf, 0x87, 0xe5, 0x5e, 0xeb, 0xc5, 0x2f, 0xa6, 0xe6, 0xd4, 0x94, 0x62, 0x9f, 0x2d,
+	0xaa, 0xa3, 0x96, 0xa9, 0x8e, 0x8b, 0xd4, 0x86, 0x7f, 0x95, 0xda, 0xd6, 0x97, 0x1a, 0x94, 0x8f,
+	0x44, 0x32, 0x0d, 0x58, 0xc5, 0xbe, 0x9f, 0x90, 0x34, 0x55, 0x0f, 0x36, 0x17, 0xd1, 0x00, 0xd6,
+	0x03, 0xdf, 0xf5, 0x16, 0x6d, 0x7c, 0x3e, 0x03, 0xf2, 0x4e, 0x64, 0x1b, 0xbd, 0x7d, 0x43, 0x0d,
+	0x83, 0xb5, 0xac, 0x36, 0x9d, 0x4d, 0x4d, 0x5d, 0x7a, 0x15, 0xf8, 0x1e, 0xf7, 0x25, 0xf0, 0x33,
+	0x56, 0xd5, 0xc0, 0xfb, 0xa0, 0x67, 0xea, 0x1d, 0x99, 0xa0, 0x63, 0xcf, 0x23, 0x69, 0xea, 0xb2,
+	0x49, 0x3c, 0xaf, 0x06, 0x90, 0xaa, 0xde, 0x24, 0x16, 0x2d, 0x1c, 0x87, 0x74, 0x1c, 0x31, 0xc1,
+	0xa8, 0xa2, 0xa3, 0x24, 0xd1, 0xf1, 0xc6, 0x49, 0x42, 0x22, 0x6f, 0xa2, 0x58, 0xb3, 0x90, 0x2d,
+	0x06, 0xf5, 0x6c, 0xce, 0xde, 0x10, 0xfb, 0x01, 0x94, 0x45, 0xe6, 0x64, 0xad, 0xd9, 0x2d, 0x1e,
+	0xd7, 0xcf, 0x53, 0xf3, 0xff, 0xc3, 0x80, 0x1d, 0x8f, 0xfb, 0x2d, 0x8f, 0x86, 0x6d, 0x8f, 0xa6,
+	0x21, 0x4d, 0xd5, 0xe7, 0xfd, 0xd4, 0x3f, 0x69, 0x73, 0x4f, 0xd3, 0xd6, 0x01, 0xf1, 0x1c, 0xb9,
+	0x59, 0x45, 0xf6, 0x29, 0xd4, 0x16, 0x7d, 0x02, 0xdd, 0x02, 0x3d, 0x0c, 0x22, 0xf7, 0x98, 0x8e,
+	0xfc, 0x20, 0x1a, 0x8a, 0x6b, 0x4b, 0xf6, 0xe6, 0xb2, 0xb6, 0x32, 0x46, 0xcb, 0x81, 0x30, 0x88,
+	0xee, 0x4b, 0x01, 0xb5, 0xa1, 0x82, 0xbd, 0x45, 0xf9, 0xaf, 0xef, 0x5d, 0xcb, 0xbd, 0x02, 0x3f,
+	0x7b, 0x5f, 0x98, 0x1d, 0x05, 0x53, 0x97, 0x7f, 0xaf, 0xc1, 0xc6, 0x43, 0xea, 0x9d, 0x10, 0x9f,
+	0x43, 0x84, 0x1f, 0x6f, 0x3d, 0x8e, 0x1f, 0x00, 0x3a, 0x37, 0x92, 0xf8, 0x4e, 0x99, 0x99, 0xed,
+	0xd9, 0xd4, 0xbc, 0x3e, 0xdf, 0x79, 0x1e, 0x63, 0x39, 0x8d, 0xfc, 0xcc, 0xea, 0xf8, 0x68, 0x17,
+	0x2a, 0x72, 0x84, 0xab, 0x36, 0x75, 0x69, 0x36, 0x35, 0xd7, 0xb2, 0xa3, 0xdd, 0x72, 0xca, 0x62,
+	0xb2, 0x5b, 0xcf, 0x61, 0x53, 0x6c, 0x12, 0x3c, 0x4d, 0x8f, 0x83, 0xb8, 0xa7, 0x0a, 0xfc, 0xad,
+	0x03, 0xe0, 0xe3, 0x9e, 0x7b, 0x91, 0xa8, 0xd6, 0xa9, 0x24, 0xd1, 0x24, 0x88, 0x17, 0xc4, 0x01,
+	0x89, 0x98, 0x22, 0xcb, 0x52, 0x61, 0x7d, 0xab, 0x41, 0xd9, 0xe6, 0x0d, 0x1a, 0xdd, 0x3e, 0x37,
+	0x68, 0xe5, 0x9d, 0xd7, 0x66, 0x53, 0xf3, 0xb2, 0x2a, 0xef, 0x8c, 0xd5, 0xca, 0x4f, 0xe0, 0xac,
+	0xaf, 0x85, 0xbf, 0xe1, 0x6b, 0x1b, 0x8a, 0xd1, 0x40, 0x7a, 0xf3, 0x67, 0xbf, 0x5b, 0xba, 0xaa,
+	0x30, 0xde, 0x67, 0x1d, 0x8e, 0xb4, 0x3e, 0xd3, 0x40, 0xef, 0x1e, 0xf6, 0xf6, 0xe3, 0x38, 0xa1,
+	0xa7, 0x78, 0xf4, 0xd6, 0xc9, 0x59, 0x3e, 0x48, 0xe1, 0xcd, 0x0f, 0xc2, 0xcb, 0x25, 0x8d, 0x65,
+	0x1e, 0x65, 0xb2, 0xe6, 0xa2, 0xc5, 0xa0, 0x71, 0x14, 0x93, 0x84, 0x77, 0xd0, 0x85, 0x1f, 0x8b,
+	0xde, 0xad, 0x65, 0x7b, 0xf7, 0x16, 0x54, 0xa9, 0x42, 0xaa, 0xc7, 0x58, 0xc8, 0x39, 0xcf, 0x8b,
+	0x7f, 0xed, 0xf9, 0xbb, 0xcf, 0x01, 0x96, 0xbc, 0x47, 0x16, 0x34, 0xee, 0xed, 0xf7, 0xee, 0xba,
+	0xfb, 0x77, 0x7a, 0x9d, 0xa3, 0xae, 0x7b, 0xff, 0xe8, 0xe1, 0x41, 0x63, 0x65, 0xab, 0xfe, 0xc5,
+	0x57, 0x3b, 0x55, 0x8e, 0xe2, 0x75, 0x74, 0x1e, 0x63, 0x3f, 0x75, 0xba, 0x0d, 0x6d, 0x89, 0xb1,
+	0xc7, 0xc9, 0x85, 0x73, 0x1e, 0x1e, 0xdd, 0x79, 0xd0, 0x28, 0x2c, 0x31, 0xbc, 0x98, 0xb6, 0x4a,
+	0x9f, 0x7f, 0xd3, 0x5c, 0xb1, 0xed, 0x97, 0xbf, 0x35, 0x57, 0x5e, 0x9e, 0x35, 0xb5, 0x57, 0x67,
+	0x4d, 0xed, 0xd7, 0xb3, 0xa6, 0xf6, 0xe2, 0x75, 0x73, 0xe5, 0xd5, 0xeb, 0xe6, 0xca, 0x4f, 0xaf,
+	0x9b, 0x2b, 0x1f, 0xdf, 0xc8, 0xf4, 0x8a, 0xfd, 0x31, 0xa3, 0x11, 0x0d, 0x27, 0x5d, 0xc2, 0x9e,
+	0xd1, 0xe4, 0x84, 0xff, 0xe0, 0xcb, 0x6e, 0xd1, 0xaf, 0x88, 0xd9, 0xf1, 0xc1, 0x1f, 0x03, 0x00,
+	0x73, 0x0a, 0xfc, 0xb5, 0x00, 0x0c, 0x00, 0x00,
 }
 
 func (this *IDCollection) Equal(that interface{}) bool {
@@ -451,6 +810,60 @@ func (this *Owner) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RoyaltyShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoyaltyShare)
+	if !ok {
+		that2, ok := that.(RoyaltyShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Share.Equal(that1.Share) {
+		return false
+	}
+	return true
+}
+func (this *TokenGate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenGate)
+	if !ok {
+		that2, ok := that.(TokenGate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinHolding != that1.MinHolding {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	return true
+}
 func (m *Collection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -557,6 +970,44 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Badge {
+		i--
+		if m.Badge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.TokenGate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.RoyaltyShares) > 0 {
+		for iNdEx := len(m.RoyaltyShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size, err := m.PaymentInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -733,6 +1184,20 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoyaltyShares) > 0 {
+		for iNdEx := len(m.RoyaltyShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -740,12 +1205,12 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintNft(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintNft(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x42
 	if m.Listed {
@@ -895,16 +1360,312 @@ func (m *PaymentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNft(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RoyaltyShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenGate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenGate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenGate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHolding != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MinHolding))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedGateToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedGateToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedGateToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DependentDenomId) > 0 {
+		i -= len(m.DependentDenomId)
+		copy(dAtA[i:], m.DependentDenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DependentDenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Badge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Badge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Badge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NFT.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNft(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Collection) Size() (n int) {
 	if m == nil {
@@ -1001,6 +1762,17 @@ func (m *Denom) Size() (n int) {
 	}
 	l = m.PaymentInfo.Size()
 	n += 1 + l + sovNft(uint64(l))
+	if len(m.RoyaltyShares) > 0 {
+		for _, e := range m.RoyaltyShares {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	l = m.TokenGate.Size()
+	n += 2 + l + sovNft(uint64(l))
+	if m.Badge {
+		n += 3
+	}
 	return n
 }
 
@@ -1065,6 +1837,12 @@ func (m *NFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNft(uint64(l))
 	}
+	if len(m.RoyaltyShares) > 0 {
+		for _, e := range m.RoyaltyShares {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1107,12 +1885,145 @@ func (m *PaymentInfo) Size() (n int) {
 	return n
 }
 
-func sovNft(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNft(x uint64) (n int) {
-	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *RoyaltyShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
+func (m *TokenGate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHolding != 0 {
+		n += 1 + sovNft(uint64(m.MinHolding))
+	}
+	if m.Action != 0 {
+		n += 1 + sovNft(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *LockedGateToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.DependentDenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *Badge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.NFT.Size()
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
+func (m *NFTApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func sovNft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNft(x uint64) (n int) {
+	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *Collection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1144,9 +2055,852 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTs = append(m.NFTs, NFT{})
+			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependentDenoms = append(m.DependentDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrimarySale = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNfts", wireType)
+			}
+			m.TotalNfts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalNfts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableNfts", wireType)
+			}
+			m.AvailableNfts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableNfts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyShares = append(m.RoyaltyShares, RoyaltyShare{})
+			if err := m.RoyaltyShares[len(m.RoyaltyShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenGate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenGate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Badge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Badge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaURI", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1156,30 +2910,29 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MediaURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1189,25 +2942,23 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NFTs = append(m.NFTs, NFT{})
-			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1230,7 +2981,7 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDCollection) Unmarshal(dAtA []byte) error {
+func (m *NFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,15 +3004,15 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1289,13 +3040,13 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1305,77 +3056,28 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNft(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNft
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Denom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNft
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1403,11 +3105,31 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,11 +3157,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Royalties = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1467,13 +3189,33 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Listed = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1483,27 +3225,28 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1531,13 +3274,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1547,27 +3290,79 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			m.RoyaltyShares = append(m.RoyaltyShares, RoyaltyShare{})
+			if err := m.RoyaltyShares[len(m.RoyaltyShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Owner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DependentDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1595,13 +3390,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DependentDenoms = append(m.DependentDenoms, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1611,27 +3406,79 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			m.IDCollections = append(m.IDCollections, IDCollection{})
+			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1659,13 +3506,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
+			m.AccessType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1675,17 +3522,16 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Amount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PrimarySale = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalNfts", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
-			m.TotalNfts = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1695,33 +3541,77 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalNfts |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableNfts", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
 			}
-			m.AvailableNfts = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AvailableNfts |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
 			}
-		case 13:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1749,13 +3639,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1765,22 +3655,23 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1805,7 +3696,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *TokenGate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,17 +3719,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenGate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenGate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHolding", wireType)
 			}
-			var stringLen uint64
+			m.MinHolding = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1848,27 +3739,83 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinHolding |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= GateAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNft
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedGateToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedGateToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedGateToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1896,11 +3843,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentDenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1928,11 +3875,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaURI = string(dAtA[iNdEx:postIndex])
+			m.DependentDenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1960,7 +3907,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1983,7 +3930,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NFT) Unmarshal(dAtA []byte) error {
+func (m *DenomOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2006,15 +3953,15 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2042,13 +3989,13 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -2058,28 +4005,27 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2107,31 +4053,61 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
 			}
-			m.Transferable = bool(v != 0)
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Badge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Badge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Badge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2159,11 +4135,11 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Royalties = string(dAtA[iNdEx:postIndex])
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,31 +4167,11 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Listed = bool(v != 0)
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NFT", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2242,42 +4198,10 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NFT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -2299,7 +4223,7 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Owner) Unmarshal(dAtA []byte) error {
+func (m *NFTApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2322,15 +4246,15 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFTApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFTApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2358,13 +4282,13 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -2374,25 +4298,55 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IDCollections = append(m.IDCollections, IDCollection{})
-			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2415,7 +4369,7 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
+func (m *OperatorApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2438,15 +4392,15 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PaymentInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2474,13 +4428,13 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -2490,14 +4444,27 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,7 +4492,7 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Currency = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,222 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MaxFeeBps is the fee in basis points that takes the whole sale price
+const MaxFeeBps = 10000
+
+var (
+	KeyFilledOrderRetention = []byte("FilledOrderRetention")
+	KeyMarketplaceFeeBps    = []byte("MarketplaceFeeBps")
+	KeyCommunityFees        = []byte("CommunityFees")
+	KeyDenomCreationFee     = []byte("DenomCreationFee")
+	KeyMaxRoyalty           = []byte("MaxRoyalty")
+	KeyAllowedPaymentDenoms = []byte("AllowedPaymentDenoms")
+	KeyMinListingDuration   = []byte("MinListingDuration")
+	KeyMaxListingDuration   = []byte("MaxListingDuration")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table of the nft module parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(filledOrderRetention time.Duration, marketplaceFeeBps uint32, communityFees []CommunityFee,
+	denomCreationFee sdk.Coins, maxRoyalty sdk.Dec, allowedPaymentDenoms []string, minListingDuration, maxListingDuration time.Duration) Params {
+	return Params{
+		FilledOrderRetention: filledOrderRetention,
+		MarketplaceFeeBps:    marketplaceFeeBps,
+		CommunityFees:        communityFees,
+		DenomCreationFee:     denomCreationFee,
+		MaxRoyalty:           maxRoyalty,
+		AllowedPaymentDenoms: allowedPaymentDenoms,
+		MinListingDuration:   minListingDuration,
+		MaxListingDuration:   maxListingDuration,
+	}
+}
+
+// DefaultParams returns the default nft module parameters
+func DefaultParams() Params {
+	return NewParams(0, 0, []CommunityFee{}, sdk.Coins{}, sdk.OneDec(), []string{}, 0, 0)
+}
+
+// IsAllowedPaymentDenom returns true if nfts can be priced in the given denom
+func (p Params) IsAllowedPaymentDenom(denom string) bool {
+	if len(p.AllowedPaymentDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedPaymentDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFeeBps returns the fee charged on sales of a community's denoms and whether the
+// community overrides the protocol fee
+func (p Params) GetFeeBps(communityID string) (uint32, bool) {
+	if len(communityID) > 0 {
+		for _, fee := range p.CommunityFees {
+			if fee.CommunityId == communityID {
+				return fee.FeeBps, true
+			}
+		}
+	}
+	return p.MarketplaceFeeBps, false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFilledOrderRetention, &p.FilledOrderRetention, validateFilledOrderRetention),
+		paramtypes.NewParamSetPair(KeyMarketplaceFeeBps, &p.MarketplaceFeeBps, validateFeeBps),
+		paramtypes.NewParamSetPair(KeyCommunityFees, &p.CommunityFees, validateCommunityFees),
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyMaxRoyalty, &p.MaxRoyalty, validateMaxRoyalty),
+		paramtypes.NewParamSetPair(KeyAllowedPaymentDenoms, &p.AllowedPaymentDenoms, validateAllowedPaymentDenoms),
+		paramtypes.NewParamSetPair(KeyMinListingDuration, &p.MinListingDuration, validateListingDuration),
+		paramtypes.NewParamSetPair(KeyMaxListingDuration, &p.MaxListingDuration, validateListingDuration),
+	}
+}
+
+// Validate performs basic validation of the nft module parameters
+func (p Params) Validate() error {
+	if err := validateFilledOrderRetention(p.FilledOrderRetention); err != nil {
+		return err
+	}
+	if err := validateFeeBps(p.MarketplaceFeeBps); err != nil {
+		return err
+	}
+	if err := validateCommunityFees(p.CommunityFees); err != nil {
+		return err
+	}
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateMaxRoyalty(p.MaxRoyalty); err != nil {
+		return err
+	}
+	if err := validateAllowedPaymentDenoms(p.AllowedPaymentDenoms); err != nil {
+		return err
+	}
+	if err := validateListingDuration(p.MinListingDuration); err != nil {
+		return err
+	}
+	if err := validateListingDuration(p.MaxListingDuration); err != nil {
+		return err
+	}
+
+	if p.MaxListingDuration > 0 && p.MinListingDuration > p.MaxListingDuration {
+		return fmt.Errorf("min listing duration %s is longer than the max listing duration %s", p.MinListingDuration, p.MaxListingDuration)
+	}
+	return nil
+}
+
+func validateFilledOrderRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("filled order retention cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateFeeBps(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxFeeBps {
+		return fmt.Errorf("fee cannot be more than %d bps: %d", MaxFeeBps, v)
+	}
+	return nil
+}
+
+func validateCommunityFees(i interface{}) error {
+	v, ok := i.([]CommunityFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, fee := range v {
+		if len(fee.CommunityId) == 0 {
+			return fmt.Errorf("community fee must have a community id")
+		}
+		if seen[fee.CommunityId] {
+			return fmt.Errorf("duplicate community fee for %s", fee.CommunityId)
+		}
+		seen[fee.CommunityId] = true
+
+		if err := validateFeeBps(fee.FeeBps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateMaxRoyalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max royalty must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateAllowedPaymentDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate payment denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateListingDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("listing duration cannot be negative: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,868 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governance controlled parameters of the nft module.
+type Params struct {
+	// filled_order_retention is how long a filled market place order is kept
+	// before it is pruned. Zero keeps filled orders forever.
+	FilledOrderRetention time.Duration `protobuf:"bytes,1,opt,name=filled_order_retention,json=filledOrderRetention,proto3,stdduration" json:"filled_order_retention" yaml:"filled_order_retention"`
+	// marketplace_fee_bps is the protocol fee charged on every sale, in basis points.
+	MarketplaceFeeBps uint32 `protobuf:"varint,2,opt,name=marketplace_fee_bps,json=marketplaceFeeBps,proto3" json:"marketplace_fee_bps,omitempty" yaml:"marketplace_fee_bps"`
+	// community_fees overrides the protocol fee for sales of denoms that belong to a
+	// community. The fee is paid to the community treasury instead of the fee pool.
+	CommunityFees    []CommunityFee                           `protobuf:"bytes,3,rep,name=community_fees,json=communityFees,proto3" json:"community_fees" yaml:"community_fees"`
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// max_royalty is the highest royalty a creator can set on an nft.
+	MaxRoyalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_royalty,json=maxRoyalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_royalty" yaml:"max_royalty"`
+	// allowed_payment_denoms restricts the denoms nfts can be priced in. Empty allows
+	// every denom.
+	AllowedPaymentDenoms []string `protobuf:"bytes,6,rep,name=allowed_payment_denoms,json=allowedPaymentDenoms,proto3" json:"allowed_payment_denoms,omitempty" yaml:"allowed_payment_denoms"`
+	// min_listing_duration is the shortest time a listing or auction can run.
+	MinListingDuration time.Duration `protobuf:"bytes,7,opt,name=min_listing_duration,json=minListingDuration,proto3,stdduration" json:"min_listing_duration" yaml:"min_listing_duration"`
+	// max_listing_duration is the longest time a listing or auction can run. Listings
+	// without an expiry expire after it. Zero allows listings to run forever.
+	MaxListingDuration time.Duration `protobuf:"bytes,8,opt,name=max_listing_duration,json=maxListingDuration,proto3,stdduration" json:"max_listing_duration" yaml:"max_listing_duration"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c841efcf087c4fa8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// CommunityFee defines the fee charged on sales of a community's denoms.
+type CommunityFee struct {
+	CommunityId string `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	FeeBps      uint32 `protobuf:"varint,2,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty" yaml:"fee_bps"`
+}
+
+func (m *CommunityFee) Reset()         { *m = CommunityFee{} }
+func (m *CommunityFee) String() string { return proto.CompactTextString(m) }
+func (*CommunityFee) ProtoMessage()    {}
+func (*CommunityFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c841efcf087c4fa8, []int{1}
+}
+func (m *CommunityFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityFee.Merge(m, src)
+}
+func (m *CommunityFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityFee proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "nft.v1beta1.Params")
+	proto.RegisterType((*CommunityFee)(nil), "nft.v1beta1.CommunityFee")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/params.proto", fileDescriptor_c841efcf087c4fa8) }
+
+var fileDescriptor_c841efcf087c4fa8 = []byte{
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x06, 0x1d, 0x73, 0xb7, 0x09, 0xbc, 0x32, 0xb2, 0xa1, 0x25, 0x25, 0x42, 0x50,
+	0x84, 0x48, 0x34, 0xb8, 0xed, 0x46, 0x56, 0x4d, 0x42, 0x82, 0x31, 0xe5, 0x82, 0xc4, 0x25, 0x72,
+	0x13, 0xb7, 0x44, 0x8b, 0xed, 0x28, 0x76, 0x59, 0xc3, 0x53, 0x70, 0x42, 0x3c, 0x03, 0x4f, 0xb2,
+	0xe3, 0x8e, 0x88, 0x43, 0x06, 0xdb, 0x1b, 0xf4, 0xc8, 0x09, 0xc5, 0x76, 0xb6, 0x6e, 0x2b, 0x02,
+	0x4e, 0xad, 0xfd, 0xff, 0xbe, 0xff, 0x2f, 0xf9, 0xfa, 0xff, 0x0a, 0x4c, 0x3a, 0x10, 0xde, 0x87,
+	0xcd, 0x3e, 0x16, 0x68, 0xd3, 0xcb, 0x50, 0x8e, 0x08, 0x77, 0xb3, 0x9c, 0x09, 0x06, 0x5b, 0x74,
+	0x20, 0x5c, 0xad, 0xac, 0xb7, 0x87, 0x6c, 0xc8, 0xe4, 0xbd, 0x57, 0x7d, 0x53, 0x25, 0xeb, 0xd6,
+	0x90, 0xb1, 0x61, 0x8a, 0x3d, 0x79, 0xea, 0x8f, 0x06, 0x5e, 0x3c, 0xca, 0x91, 0x48, 0x18, 0xad,
+	0xf5, 0x88, 0x71, 0xc2, 0xb8, 0xd7, 0x47, 0x1c, 0x9f, 0x41, 0x22, 0x96, 0x68, 0xdd, 0xf9, 0xd5,
+	0x04, 0xcd, 0x3d, 0xc9, 0x84, 0x1f, 0xc1, 0xea, 0x20, 0x49, 0x53, 0x1c, 0x87, 0x2c, 0x8f, 0x71,
+	0x1e, 0xe6, 0x58, 0x60, 0x5a, 0x59, 0x99, 0x46, 0xc7, 0xe8, 0xb6, 0x9e, 0xad, 0xb9, 0x8a, 0xe5,
+	0xd6, 0x2c, 0xb7, 0xa7, 0x59, 0xfe, 0xe3, 0xc3, 0xd2, 0x6e, 0x4c, 0x4a, 0x7b, 0xa3, 0x40, 0x24,
+	0xdd, 0x72, 0x66, 0xdb, 0x38, 0x5f, 0x8e, 0x6d, 0x23, 0x68, 0x2b, 0xf1, 0x4d, 0xa5, 0x05, 0xb5,
+	0x04, 0x77, 0xc1, 0x0a, 0x41, 0xf9, 0x3e, 0x16, 0x59, 0x8a, 0x22, 0x1c, 0x0e, 0x30, 0x0e, 0xfb,
+	0x19, 0x37, 0xaf, 0x75, 0x8c, 0xee, 0x92, 0x6f, 0x4d, 0x4a, 0x7b, 0x5d, 0x39, 0xcf, 0x28, 0x72,
+	0x82, 0xdb, 0x53, 0xb7, 0x3b, 0x18, 0xfb, 0x19, 0x87, 0x21, 0x58, 0x8e, 0x18, 0x21, 0x23, 0x9a,
+	0x88, 0xa2, 0x2a, 0xe4, 0xe6, 0x5c, 0x67, 0x4e, 0xbe, 0xc3, 0xd4, 0x48, 0xdd, 0xed, 0xba, 0xa4,
+	0xea, 0xda, 0xd0, 0xef, 0x70, 0x47, 0x91, 0x2e, 0xb6, 0x3b, 0xc1, 0x52, 0x34, 0x55, 0xcc, 0xe1,
+	0x67, 0x03, 0xc0, 0x18, 0x53, 0x46, 0xc2, 0x28, 0xc7, 0x72, 0x08, 0x55, 0x9d, 0x79, 0x5d, 0x53,
+	0xd4, 0xd4, 0xdd, 0x6a, 0xea, 0x53, 0xb4, 0x84, 0xfa, 0xaf, 0x35, 0x65, 0x4d, 0x51, 0xae, 0x5a,
+	0x38, 0x5f, 0x8f, 0xed, 0xee, 0x30, 0x11, 0xef, 0x47, 0x7d, 0x37, 0x62, 0xc4, 0xd3, 0xbf, 0x9f,
+	0xfa, 0x78, 0xca, 0xe3, 0x7d, 0x4f, 0x14, 0x19, 0xe6, 0xd2, 0x8d, 0x07, 0xb7, 0xa4, 0xc1, 0xb6,
+	0xee, 0xdf, 0xc1, 0x18, 0x62, 0xd0, 0x22, 0x68, 0x1c, 0xe6, 0xac, 0x40, 0xa9, 0x28, 0xcc, 0x1b,
+	0x1d, 0xa3, 0xbb, 0xe0, 0xf7, 0x2a, 0xea, 0xf7, 0xd2, 0x7e, 0xf8, 0x0f, 0xc6, 0x3d, 0x1c, 0x4d,
+	0x4a, 0x1b, 0xd6, 0xf3, 0x3e, 0xb3, 0x72, 0x02, 0x40, 0xd0, 0x38, 0x50, 0x07, 0xf8, 0x16, 0xac,
+	0xa2, 0x34, 0x65, 0x07, 0x38, 0x0e, 0x33, 0x54, 0x10, 0x4c, 0x45, 0x28, 0x1f, 0x85, 0x9b, 0xcd,
+	0xce, 0x5c, 0x77, 0xc1, 0xbf, 0x7f, 0x9e, 0x86, 0xd9, 0x75, 0x4e, 0xd0, 0xd6, 0xc2, 0x9e, 0xba,
+	0xef, 0xc9, 0x6b, 0x28, 0x40, 0x9b, 0x24, 0x34, 0x4c, 0x13, 0x2e, 0x12, 0x3a, 0x0c, 0xeb, 0x38,
+	0x9b, 0xf3, 0x7f, 0xcb, 0xe0, 0x23, 0x3d, 0xd9, 0x7b, 0xfa, 0xc9, 0x67, 0x98, 0xa8, 0x04, 0x42,
+	0x92, 0xd0, 0x57, 0x4a, 0xa9, 0x9b, 0x25, 0x15, 0x8d, 0xaf, 0x52, 0x6f, 0xfe, 0x2f, 0x15, 0x8d,
+	0xff, 0x48, 0x45, 0xe3, 0x4b, 0x54, 0xe7, 0x00, 0x2c, 0x4e, 0x47, 0x10, 0x6e, 0x81, 0xc5, 0xf3,
+	0xd8, 0x25, 0xb1, 0xdc, 0xbb, 0x05, 0xff, 0xee, 0xa4, 0xb4, 0x57, 0x2e, 0x87, 0x32, 0x89, 0x9d,
+	0xa0, 0x75, 0x76, 0x7c, 0x19, 0xc3, 0x27, 0x60, 0xfe, 0xe2, 0xd6, 0xc0, 0x49, 0x69, 0x2f, 0xeb,
+	0x7d, 0xac, 0x37, 0xa5, 0x39, 0x90, 0xeb, 0xe1, 0xfb, 0x87, 0x3f, 0xad, 0xc6, 0xe1, 0x89, 0x65,
+	0x1c, 0x9d, 0x58, 0xc6, 0x8f, 0x13, 0xcb, 0xf8, 0x74, 0x6a, 0x35, 0x8e, 0x4e, 0xad, 0xc6, 0xb7,
+	0x53, 0xab, 0xf1, 0xee, 0xc1, 0x54, 0x4a, 0x5e, 0x8c, 0x04, 0xa3, 0x8c, 0x14, 0xbb, 0x58, 0x1c,
+	0xb0, 0x7c, 0xdf, 0xab, 0xfe, 0xab, 0x64, 0x4e, 0xfa, 0x4d, 0x39, 0x8c, 0xe7, 0xbf, 0x07, 0x00,
+	0x2f, 0x1f, 0x51, 0xa8, 0xbf, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxListingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxListingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinListingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinListingDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AllowedPaymentDenoms) > 0 {
+		for iNdEx := len(m.AllowedPaymentDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPaymentDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedPaymentDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedPaymentDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MaxRoyalty.Size()
+		i -= size
+		if _, err := m.MaxRoyalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityFees) > 0 {
+		for iNdEx := len(m.CommunityFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketplaceFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MarketplaceFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FilledOrderRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FilledOrderRetention):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FilledOrderRetention)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MarketplaceFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.MarketplaceFeeBps))
+	}
+	if len(m.CommunityFees) > 0 {
+		for _, e := range m.CommunityFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxRoyalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedPaymentDenoms) > 0 {
+		for _, s := range m.AllowedPaymentDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinListingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxListingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *CommunityFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeBps != 0 {
+		n += 1 + sovParams(uint64(m.FeeBps))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledOrderRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FilledOrderRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketplaceFeeBps", wireType)
+			}
+			m.MarketplaceFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketplaceFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityFees = append(m.CommunityFees, CommunityFee{})
+			if err := m.CommunityFees[len(m.CommunityFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoyalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRoyalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPaymentDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPaymentDenoms = append(m.AllowedPaymentDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinListingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinListingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxListingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxListingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultVotingPeriod is how long community proposals are open for votes when the community sets no voting period
+const DefaultVotingPeriod = 72 * time.Hour
+
+// GetVotingPeriod returns how long the proposals of the community are open for votes
+func (c Community) GetVotingPeriod() time.Duration {
+	if c.VotingPeriod <= 0 {
+		return DefaultVotingPeriod
+	}
+	return c.VotingPeriod
+}
+
+// ValidateProposalContent checks a community proposal has what its kind needs to be executed
+func ValidateProposalContent(kind ProposalKind, address, amount string) error {
+	switch kind {
+	case ProposalText, ProposalUpdateMetadata:
+		return nil
+
+	case ProposalTreasurySpend:
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spend recipient %s", err)
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "invalid spend amount %s", err)
+		}
+
+		if !coins.IsAllPositive() {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "spend amount must be positive")
+		}
+		return nil
+
+	case ProposalApproveCreator:
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %s", err)
+		}
+		return nil
+
+	default:
+		return sdkerrors.Wrapf(ErrInvalidProposal, "unknown proposal kind %s", kind)
+	}
+}
+
+// Passes returns true if enough of the voting power voted and more of it voted yes than no
+func (t TallyResult) Passes(quorumBps uint32) bool {
+	if t.TotalPower == 0 {
+		return false
+	}
+
+	voted := sdk.NewIntFromUint64(t.Yes).Add(sdk.NewIntFromUint64(t.No)).Add(sdk.NewIntFromUint64(t.Abstain))
+	if voted.MulRaw(MaxFeeBps).LT(sdk.NewIntFromUint64(t.TotalPower).MulRaw(int64(quorumBps))) {
+		return false
+	}
+	return t.Yes > t.No
+}
+
+// ParseProposalKind parses a proposal kind from its name, e.g. text, treasury_spend, update_metadata or approve_creator
+func ParseProposalKind(kind string) (ProposalKind, error) {
+	value, ok := ProposalKind_value["PROPOSAL_KIND_"+strings.ToUpper(strings.TrimSpace(kind))]
+	if !ok {
+		return ProposalUnspecified, sdkerrors.Wrapf(ErrInvalidProposal, "unknown proposal kind %s", kind)
+	}
+	return ProposalKind(value), nil
+}
+
+// ParseVoteOption parses a vote option from its name, e.g. yes, no or abstain
+func ParseVoteOption(option string) (VoteOption, error) {
+	value, ok := VoteOption_value["VOTE_OPTION_"+strings.ToUpper(strings.TrimSpace(option))]
+	if !ok || VoteOption(value) == VoteUnspecified {
+		return VoteUnspecified, sdkerrors.Wrapf(ErrInvalidProposal, "unknown vote option %s", option)
+	}
+	return VoteOption(value), nil
+}
+
+// ParseVotingWeight parses a voting weight from its name, e.g. member or nft
+func ParseVotingWeight(weight string) (VotingWeight, error) {
+	value, ok := VotingWeight_value["VOTING_WEIGHT_"+strings.ToUpper(strings.TrimSpace(weight))]
+	if !ok {
+		return WeightMember, sdkerrors.Wrapf(ErrInvalidProposal, "unknown voting weight %s", weight)
+	}
+	return VotingWeight(value), nil
+}
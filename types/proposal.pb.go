@@ -0,0 +1,1763 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposalKind is the decision a community proposal executes when it passes
+type ProposalKind int32
+
+const (
+	ProposalUnspecified ProposalKind = 0
+	// records a decision without executing anything
+	ProposalText ProposalKind = 1
+	// sends amount from the community treasury to address
+	ProposalTreasurySpend ProposalKind = 2
+	// replaces the description, data and tags of the community with metadata
+	ProposalUpdateMetadata ProposalKind = 3
+	// gives address the creator role so it can launch collections in the community
+	ProposalApproveCreator ProposalKind = 4
+)
+
+var ProposalKind_name = map[int32]string{
+	0: "PROPOSAL_KIND_UNSPECIFIED",
+	1: "PROPOSAL_KIND_TEXT",
+	2: "PROPOSAL_KIND_TREASURY_SPEND",
+	3: "PROPOSAL_KIND_UPDATE_METADATA",
+	4: "PROPOSAL_KIND_APPROVE_CREATOR",
+}
+
+var ProposalKind_value = map[string]int32{
+	"PROPOSAL_KIND_UNSPECIFIED":     0,
+	"PROPOSAL_KIND_TEXT":            1,
+	"PROPOSAL_KIND_TREASURY_SPEND":  2,
+	"PROPOSAL_KIND_UPDATE_METADATA": 3,
+	"PROPOSAL_KIND_APPROVE_CREATOR": 4,
+}
+
+func (x ProposalKind) String() string {
+	return proto.EnumName(ProposalKind_name, int32(x))
+}
+
+func (ProposalKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{0}
+}
+
+// ProposalStatus is the state of a community proposal
+type ProposalStatus int32
+
+const (
+	StatusUnspecified ProposalStatus = 0
+	StatusVoting      ProposalStatus = 1
+	StatusPassed      ProposalStatus = 2
+	StatusRejected    ProposalStatus = 3
+	// the proposal passed but executing it failed
+	StatusFailed ProposalStatus = 4
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_STATUS_UNSPECIFIED",
+	1: "PROPOSAL_STATUS_VOTING",
+	2: "PROPOSAL_STATUS_PASSED",
+	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_FAILED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_STATUS_UNSPECIFIED": 0,
+	"PROPOSAL_STATUS_VOTING":      1,
+	"PROPOSAL_STATUS_PASSED":      2,
+	"PROPOSAL_STATUS_REJECTED":    3,
+	"PROPOSAL_STATUS_FAILED":      4,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{1}
+}
+
+type VoteOption int32
+
+const (
+	VoteUnspecified VoteOption = 0
+	VoteYes         VoteOption = 1
+	VoteNo          VoteOption = 2
+	VoteAbstain     VoteOption = 3
+)
+
+var VoteOption_name = map[int32]string{
+	0: "VOTE_OPTION_UNSPECIFIED",
+	1: "VOTE_OPTION_YES",
+	2: "VOTE_OPTION_NO",
+	3: "VOTE_OPTION_ABSTAIN",
+}
+
+var VoteOption_value = map[string]int32{
+	"VOTE_OPTION_UNSPECIFIED": 0,
+	"VOTE_OPTION_YES":         1,
+	"VOTE_OPTION_NO":          2,
+	"VOTE_OPTION_ABSTAIN":     3,
+}
+
+func (x VoteOption) String() string {
+	return proto.EnumName(VoteOption_name, int32(x))
+}
+
+func (VoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{2}
+}
+
+// CommunityMetadata is the metadata of a community set by a proposal
+type CommunityMetadata struct {
+	Description string   `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Data        string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Tags        []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *CommunityMetadata) Reset()         { *m = CommunityMetadata{} }
+func (m *CommunityMetadata) String() string { return proto.CompactTextString(m) }
+func (*CommunityMetadata) ProtoMessage()    {}
+func (*CommunityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{0}
+}
+func (m *CommunityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMetadata.Merge(m, src)
+}
+func (m *CommunityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMetadata proto.InternalMessageInfo
+
+// TallyResult is the voting power of each option of a community proposal
+type TallyResult struct {
+	Yes     uint64 `protobuf:"varint,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	No      uint64 `protobuf:"varint,2,opt,name=no,proto3" json:"no,omitempty"`
+	Abstain uint64 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	// total_power is the voting power of the whole community when the votes were counted
+	TotalPower uint64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty" yaml:"total_power"`
+}
+
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{1}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+// CommunityProposal is a decision the members of a community vote on
+type CommunityProposal struct {
+	Id          uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommunityId string       `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty" yaml:"community_id"`
+	Proposer    string       `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title       string       `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        ProposalKind `protobuf:"varint,6,opt,name=kind,proto3,enum=nft.v1beta1.ProposalKind" json:"kind,omitempty"`
+	// address is the recipient of a treasury spend or the approved creator
+	Address string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the coins sent by a treasury spend
+	Amount        string            `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Metadata      CommunityMetadata `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata"`
+	Status        ProposalStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=nft.v1beta1.ProposalStatus" json:"status,omitempty"`
+	SubmitTime    time.Time         `protobuf:"bytes,11,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	VotingEndTime time.Time         `protobuf:"bytes,12,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// final_tally is set once the voting period ends
+	FinalTally TallyResult `protobuf:"bytes,13,opt,name=final_tally,json=finalTally,proto3" json:"final_tally" yaml:"final_tally"`
+}
+
+func (m *CommunityProposal) Reset()         { *m = CommunityProposal{} }
+func (m *CommunityProposal) String() string { return proto.CompactTextString(m) }
+func (*CommunityProposal) ProtoMessage()    {}
+func (*CommunityProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{2}
+}
+func (m *CommunityProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityProposal.Merge(m, src)
+}
+func (m *CommunityProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityProposal proto.InternalMessageInfo
+
+// CommunityVote is the vote of an address on a community proposal with the voting power
+// the voter had when casting it.
+type CommunityVote struct {
+	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=nft.v1beta1.VoteOption" json:"option,omitempty"`
+	Power      uint64     `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+	// nfts are the nfts the vote was cast with in communities weighting votes by nfts held. An nft
+	// votes once per proposal and only counts if the voter still holds it when the proposal is tallied.
+	NFTs []NFTRef `protobuf:"bytes,5,rep,name=nfts,proto3" json:"nfts"`
+}
+
+func (m *CommunityVote) Reset()         { *m = CommunityVote{} }
+func (m *CommunityVote) String() string { return proto.CompactTextString(m) }
+func (*CommunityVote) ProtoMessage()    {}
+func (*CommunityVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f10283ea35549d2, []int{3}
+}
+func (m *CommunityVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityVote.Merge(m, src)
+}
+func (m *CommunityVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityVote proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("nft.v1beta1.ProposalKind", ProposalKind_name, ProposalKind_value)
+	proto.RegisterEnum("nft.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("nft.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterType((*CommunityMetadata)(nil), "nft.v1beta1.CommunityMetadata")
+	proto.RegisterType((*TallyResult)(nil), "nft.v1beta1.TallyResult")
+	proto.RegisterType((*CommunityProposal)(nil), "nft.v1beta1.CommunityProposal")
+	proto.RegisterType((*CommunityVote)(nil), "nft.v1beta1.CommunityVote")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/proposal.proto", fileDescriptor_3f10283ea35549d2) }
+
+var fileDescriptor_3f10283ea35549d2 = []byte{
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x25, 0x59, 0xb6, 0x97, 0xfe, 0x61, 0xd6, 0x89, 0x4d, 0x33, 0xad, 0x44, 0x08, 0x3d,
+	0x08, 0x41, 0x2b, 0x25, 0x0e, 0x9a, 0x02, 0x29, 0x0a, 0x94, 0xb2, 0xe8, 0x42, 0x4d, 0x22, 0x11,
+	0x4b, 0xda, 0xa8, 0x5b, 0x14, 0x04, 0x65, 0xae, 0x14, 0x36, 0x12, 0x97, 0x20, 0x57, 0x4e, 0xd5,
+	0x63, 0x4f, 0x85, 0x4e, 0x79, 0x01, 0x9d, 0x7a, 0xe8, 0xb5, 0x7d, 0x0b, 0x1f, 0x73, 0xec, 0xa1,
+	0x70, 0x5b, 0xfb, 0x0d, 0xf2, 0x04, 0xc5, 0x2e, 0x49, 0x85, 0x92, 0x0d, 0xf4, 0xb6, 0xdf, 0xce,
+	0x37, 0xf3, 0xcd, 0xce, 0x7e, 0x5c, 0x02, 0xc5, 0xef, 0xd3, 0xc6, 0xf9, 0xa3, 0x1e, 0xa6, 0xce,
+	0xa3, 0x46, 0x10, 0x92, 0x80, 0x44, 0xce, 0xb0, 0x1e, 0x84, 0x84, 0x12, 0x28, 0xfa, 0x7d, 0x5a,
+	0x4f, 0x62, 0xca, 0xdd, 0x01, 0x19, 0x10, 0xbe, 0xdf, 0x60, 0xab, 0x98, 0xa2, 0x54, 0x06, 0x84,
+	0x0c, 0x86, 0xb8, 0xc1, 0x51, 0x6f, 0xdc, 0x6f, 0x50, 0x6f, 0x84, 0x23, 0xea, 0x8c, 0x82, 0x84,
+	0xb0, 0x97, 0xad, 0xef, 0x8c, 0xe9, 0xcb, 0x9f, 0xe2, 0x40, 0xf5, 0x7b, 0x70, 0xe7, 0x90, 0x8c,
+	0x46, 0x63, 0xdf, 0xa3, 0x93, 0x17, 0x98, 0x3a, 0xae, 0x43, 0x1d, 0xa8, 0x02, 0xd1, 0xc5, 0xd1,
+	0x59, 0xe8, 0x05, 0xd4, 0x23, 0xbe, 0x2c, 0xa8, 0x42, 0x6d, 0x1d, 0x65, 0xb7, 0x20, 0x04, 0x45,
+	0xc6, 0x94, 0xf3, 0x3c, 0xc4, 0xd7, 0x6c, 0x8f, 0x3a, 0x83, 0x48, 0x2e, 0xa8, 0x05, 0xb6, 0xc7,
+	0xd6, 0xd5, 0x9f, 0x05, 0x20, 0x5a, 0xce, 0x70, 0x38, 0x41, 0x38, 0x1a, 0x0f, 0x29, 0x94, 0x40,
+	0x61, 0x82, 0x23, 0x5e, 0xb1, 0x88, 0xd8, 0x12, 0x6e, 0x81, 0xbc, 0x4f, 0x78, 0x9d, 0x22, 0xca,
+	0xfb, 0x04, 0xca, 0x60, 0xd5, 0xe9, 0x45, 0xd4, 0xf1, 0x7c, 0xb9, 0xc0, 0x37, 0x53, 0x08, 0x3f,
+	0x03, 0x22, 0x25, 0xd4, 0x19, 0xda, 0x01, 0x79, 0x8d, 0x43, 0xb9, 0xc8, 0xa2, 0xcd, 0xdd, 0x77,
+	0x97, 0x15, 0x38, 0x71, 0x46, 0xc3, 0xa7, 0xd5, 0x4c, 0xb0, 0x8a, 0x00, 0x47, 0x06, 0x07, 0xbf,
+	0xad, 0x64, 0x0e, 0x69, 0x24, 0xc3, 0x65, 0xc2, 0x9e, 0x9b, 0x74, 0x92, 0xf7, 0x5c, 0xf8, 0x14,
+	0x6c, 0x9c, 0xa5, 0x24, 0xdb, 0x73, 0xe3, 0xa3, 0x35, 0xf7, 0xde, 0x5d, 0x56, 0x76, 0xe2, 0xfa,
+	0xd9, 0x68, 0x15, 0x89, 0x73, 0xd8, 0x76, 0xa1, 0x02, 0xd6, 0xe2, 0x4b, 0xc3, 0x21, 0xef, 0x7a,
+	0x1d, 0xcd, 0x31, 0xbc, 0x0b, 0x56, 0xa8, 0x47, 0x87, 0x98, 0x37, 0xbc, 0x8e, 0x62, 0xb0, 0x3c,
+	0xe2, 0x95, 0x9b, 0x23, 0xfe, 0x04, 0x14, 0x5f, 0x79, 0xbe, 0x2b, 0x97, 0x54, 0xa1, 0xb6, 0x75,
+	0xb0, 0x5f, 0xcf, 0xb8, 0xa0, 0x9e, 0x1e, 0xe2, 0x99, 0xe7, 0xbb, 0x88, 0xd3, 0xf8, 0xdc, 0x5c,
+	0x37, 0xc4, 0x51, 0x24, 0xaf, 0xf2, 0x62, 0x29, 0x84, 0xbb, 0xa0, 0xe4, 0x8c, 0xc8, 0xd8, 0xa7,
+	0xf2, 0x1a, 0x0f, 0x24, 0x08, 0x7e, 0x09, 0xd6, 0x46, 0xc9, 0x8d, 0xcb, 0xeb, 0xaa, 0x50, 0x13,
+	0x0f, 0xca, 0x0b, 0x22, 0x37, 0x7c, 0xd1, 0x2c, 0x5e, 0x5c, 0x56, 0x72, 0x68, 0x9e, 0x05, 0x1f,
+	0x83, 0x52, 0x44, 0x1d, 0x3a, 0x8e, 0x64, 0xc0, 0x9b, 0xbc, 0x7f, 0x6b, 0x93, 0x26, 0xa7, 0xa0,
+	0x84, 0x0a, 0xbf, 0x03, 0x62, 0x34, 0xee, 0x8d, 0x3c, 0x6a, 0x33, 0x93, 0xca, 0x22, 0x57, 0x56,
+	0xea, 0xb1, 0x83, 0xeb, 0xa9, 0x83, 0xeb, 0x56, 0xea, 0xe0, 0x66, 0x99, 0xa9, 0xbe, 0xbf, 0xe6,
+	0x4c, 0x72, 0xf5, 0xcd, 0xdf, 0x15, 0x01, 0x81, 0x78, 0x87, 0x25, 0xc0, 0x3e, 0xd8, 0x3e, 0x27,
+	0xd4, 0xf3, 0x07, 0x36, 0xf6, 0xdd, 0x58, 0x60, 0xe3, 0x7f, 0x05, 0xaa, 0x89, 0xc0, 0x6e, 0x2c,
+	0xb0, 0x54, 0x20, 0x16, 0xd9, 0x8c, 0x77, 0x75, 0xdf, 0xe5, 0x3a, 0xc7, 0x40, 0xec, 0x7b, 0xbe,
+	0x33, 0xb4, 0x29, 0x33, 0xb7, 0xbc, 0xc9, 0x35, 0xe4, 0x85, 0xe3, 0x67, 0x6c, 0xdf, 0x54, 0x16,
+	0x8f, 0x90, 0x49, 0xad, 0x22, 0xc0, 0x11, 0x67, 0x57, 0xff, 0x12, 0xc0, 0xe6, 0x7c, 0xec, 0x27,
+	0x84, 0x62, 0x66, 0xfa, 0xf4, 0x39, 0xb0, 0x53, 0xbb, 0x66, 0x4d, 0x9f, 0x09, 0x56, 0x11, 0x48,
+	0x51, 0xdb, 0x65, 0xb6, 0x3b, 0x27, 0x14, 0x87, 0xc9, 0x27, 0x1a, 0x03, 0xd8, 0x00, 0x25, 0x12,
+	0x3b, 0xae, 0xc0, 0x6f, 0x6c, 0x6f, 0xa1, 0x65, 0xa6, 0xd8, 0xe5, 0x61, 0x94, 0xd0, 0x58, 0x99,
+	0xcc, 0xe7, 0x86, 0x62, 0x00, 0x3f, 0x05, 0x45, 0xbf, 0x4f, 0x23, 0x79, 0x45, 0x2d, 0xd4, 0xc4,
+	0x83, 0x9d, 0x85, 0x22, 0x9d, 0x23, 0x0b, 0xe1, 0x7e, 0x73, 0x83, 0x1d, 0xf9, 0xea, 0xb2, 0x52,
+	0xec, 0x1c, 0x59, 0x11, 0xe2, 0xf4, 0x07, 0x7f, 0xe4, 0xc1, 0x46, 0xd6, 0xba, 0xf0, 0x09, 0xd8,
+	0x37, 0x50, 0xd7, 0xe8, 0x9a, 0xda, 0x73, 0xfb, 0x59, 0xbb, 0xd3, 0xb2, 0x8f, 0x3b, 0xa6, 0xa1,
+	0x1f, 0xb6, 0x8f, 0xda, 0x7a, 0x4b, 0xca, 0x29, 0x7b, 0xd3, 0x99, 0xba, 0x93, 0x26, 0x1c, 0xfb,
+	0x51, 0x80, 0xcf, 0xbc, 0xbe, 0x87, 0x5d, 0x58, 0x03, 0x70, 0x31, 0xcf, 0xd2, 0xbf, 0xb1, 0x24,
+	0x41, 0x91, 0xa6, 0x33, 0x75, 0xae, 0x60, 0xe1, 0x1f, 0x29, 0xfc, 0x1c, 0x7c, 0xb0, 0xc4, 0x44,
+	0xba, 0x66, 0x1e, 0xa3, 0x53, 0xdb, 0x34, 0xf4, 0x4e, 0x4b, 0xca, 0x2b, 0xfb, 0xd3, 0x99, 0x7a,
+	0x6f, 0x9e, 0x13, 0x62, 0x27, 0x1a, 0x87, 0x13, 0x33, 0xc0, 0xbe, 0x0b, 0xbf, 0x00, 0x1f, 0x2e,
+	0xb5, 0x67, 0xb4, 0x34, 0x4b, 0xb7, 0x5f, 0xe8, 0x96, 0xd6, 0xd2, 0x2c, 0x4d, 0x2a, 0x28, 0xca,
+	0x74, 0xa6, 0xee, 0xce, 0x5b, 0x0c, 0x5c, 0x87, 0xe2, 0xf9, 0x33, 0x7a, 0x23, 0x5d, 0x33, 0x0c,
+	0xd4, 0x3d, 0xd1, 0xed, 0x43, 0xa4, 0x6b, 0x56, 0x17, 0x49, 0xc5, 0xc5, 0x74, 0x2d, 0x08, 0x42,
+	0x72, 0x8e, 0x0f, 0x43, 0xec, 0x50, 0x12, 0x2a, 0xc5, 0x5f, 0x7e, 0x2d, 0xe7, 0x1e, 0x4c, 0xf3,
+	0x60, 0x6b, 0xf1, 0x4b, 0x82, 0x4f, 0xc0, 0xfd, 0x79, 0x5d, 0xd3, 0xd2, 0xac, 0x63, 0x73, 0x69,
+	0x6e, 0xf7, 0xa6, 0x33, 0xf5, 0x4e, 0x4c, 0xce, 0x4e, 0xed, 0x63, 0xb0, 0xbb, 0x9c, 0x77, 0xd2,
+	0xb5, 0xda, 0x9d, 0xaf, 0xd2, 0xc9, 0xc5, 0x29, 0x27, 0xdc, 0xe9, 0xb7, 0xb1, 0x0d, 0xcd, 0x34,
+	0x75, 0x36, 0xb3, 0x0c, 0xdb, 0x70, 0xa2, 0x08, 0xbb, 0xf0, 0x21, 0x90, 0x97, 0xd9, 0x48, 0xff,
+	0x5a, 0x3f, 0xb4, 0xf4, 0x96, 0x54, 0x50, 0xe0, 0x74, 0xa6, 0x6e, 0x25, 0xef, 0x00, 0xfe, 0x01,
+	0x9f, 0xd1, 0xdb, 0xbb, 0x39, 0xd2, 0xda, 0xcf, 0xf5, 0x96, 0x54, 0xcc, 0xd6, 0x3f, 0x72, 0xbc,
+	0x21, 0x76, 0x93, 0x61, 0xfc, 0x2e, 0x00, 0xf0, 0xde, 0xa4, 0xf0, 0x21, 0xd8, 0x3b, 0xe9, 0x5a,
+	0xba, 0xdd, 0x35, 0xac, 0x76, 0xb7, 0xb3, 0x34, 0x84, 0x9d, 0xe9, 0x4c, 0xdd, 0x66, 0xe4, 0xec,
+	0x08, 0x54, 0xb0, 0x9d, 0xcd, 0x38, 0xd5, 0x4d, 0x49, 0x50, 0xc4, 0xe9, 0x4c, 0x5d, 0x65, 0xcc,
+	0x53, 0x1c, 0xc1, 0x32, 0xd8, 0xca, 0x32, 0x3a, 0x5d, 0x29, 0xaf, 0x80, 0xe9, 0x4c, 0x2d, 0x31,
+	0x42, 0x87, 0xc0, 0x1a, 0xd8, 0xc9, 0xc6, 0xb5, 0xa6, 0x69, 0x69, 0xed, 0x8e, 0x54, 0x50, 0xb6,
+	0xa7, 0x33, 0x55, 0x64, 0x24, 0x2d, 0xfe, 0x5f, 0xc5, 0x2d, 0x37, 0x9b, 0x17, 0xff, 0x96, 0x73,
+	0x17, 0x57, 0x65, 0xe1, 0xed, 0x55, 0x59, 0xf8, 0xe7, 0xaa, 0x2c, 0xbc, 0xb9, 0x2e, 0xe7, 0xde,
+	0x5e, 0x97, 0x73, 0x7f, 0x5e, 0x97, 0x73, 0xdf, 0x7e, 0x34, 0xf0, 0xe8, 0xcb, 0x71, 0xaf, 0x7e,
+	0x46, 0x46, 0x0d, 0x6d, 0x4c, 0x89, 0x4f, 0x46, 0x93, 0x0e, 0xa6, 0xaf, 0x49, 0xf8, 0xaa, 0xc1,
+	0x7e, 0xd9, 0x74, 0x12, 0xe0, 0xa8, 0x57, 0xe2, 0x8f, 0xd6, 0xe3, 0xff, 0x06, 0x00, 0xeb, 0x52,
+	0x2e, 0x98, 0x26, 0x08, 0x00, 0x00,
+}
+
+func (m *CommunityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Abstain != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Abstain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.No != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.No))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Yes != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Yes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintProposal(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProposal(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
+	if m.Status != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CommunityId) > 0 {
+		i -= len(m.CommunityId)
+		copy(dAtA[i:], m.CommunityId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CommunityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NFTs) > 0 {
+		for iNdEx := len(m.NFTs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Power != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Option != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommunityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Yes != 0 {
+		n += 1 + sovProposal(uint64(m.Yes))
+	}
+	if m.No != 0 {
+		n += 1 + sovProposal(uint64(m.No))
+	}
+	if m.Abstain != 0 {
+		n += 1 + sovProposal(uint64(m.Abstain))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovProposal(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *CommunityProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProposal(uint64(m.Id))
+	}
+	l = len(m.CommunityId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovProposal(uint64(m.Kind))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovProposal(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.FinalTally.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *CommunityVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovProposal(uint64(m.Option))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProposal(uint64(m.Power))
+	}
+	if len(m.NFTs) > 0 {
+		for _, e := range m.NFTs {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommunityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			m.Yes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Yes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			m.No = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.No |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			m.Abstain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTs = append(m.NFTs, NFTRef{})
+			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
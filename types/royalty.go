@@ -0,0 +1,98 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRoyaltyShare creates a new RoyaltyShare instance
+func NewRoyaltyShare(address sdk.AccAddress, share sdk.Dec) RoyaltyShare {
+	return RoyaltyShare{
+		Address: address.String(),
+		Share:   share,
+	}
+}
+
+func (r RoyaltyShare) GetAddress() sdk.AccAddress {
+	address, _ := sdk.AccAddressFromBech32(r.Address)
+	return address
+}
+
+// TotalRoyaltyShares returns the part of the sale price paid to all the recipients
+func TotalRoyaltyShares(shares []RoyaltyShare) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, share := range shares {
+		total = total.Add(share.Share)
+	}
+	return total
+}
+
+// ValidateRoyaltyShares checks the recipients are unique and the shares add up to at most the whole price
+func ValidateRoyaltyShares(shares []RoyaltyShare) error {
+	seen := make(map[string]bool)
+	for _, share := range shares {
+		if _, err := sdk.AccAddressFromBech32(share.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient %s", err)
+		}
+		if seen[share.Address] {
+			return sdkerrors.Wrapf(ErrInvalidRoyalty, "duplicate royalty recipient %s", share.Address)
+		}
+		seen[share.Address] = true
+
+		if share.Share.IsNil() || !share.Share.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty share of %s must be positive", share.Address)
+		}
+	}
+
+	if total := TotalRoyaltyShares(shares); total.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty shares add up to %s, more than 1", total)
+	}
+	return nil
+}
+
+// ParseRoyaltyShares parses a comma separated list of address=share pairs
+func ParseRoyaltyShares(str string) ([]RoyaltyShare, error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return []RoyaltyShare{}, nil
+	}
+
+	var shares []RoyaltyShare
+	for _, pair := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), "=")
+		if len(parts) != 2 {
+			return nil, sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid royalty share %s, expected address=share", pair)
+		}
+
+		share, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid royalty share %s", err)
+		}
+		shares = append(shares, RoyaltyShare{Address: parts[0], Share: share})
+	}
+	return shares, ValidateRoyaltyShares(shares)
+}
+
+// ReplaceRoyaltyRecipient pays the shares of the from address to the to address, merging them
+// with any share the to address already has. It reports whether any share changed.
+func ReplaceRoyaltyRecipient(shares []RoyaltyShare, from, to string) ([]RoyaltyShare, bool) {
+	found := false
+	moved := sdk.ZeroDec()
+	replaced := make([]RoyaltyShare, 0, len(shares))
+	for _, share := range shares {
+		if share.Address == from {
+			found = true
+		}
+		if share.Address == from || share.Address == to {
+			moved = moved.Add(share.Share)
+			continue
+		}
+		replaced = append(replaced, share)
+	}
+	if !found {
+		return shares, false
+	}
+	return append(replaced, RoyaltyShare{Address: to, Share: moved}), true
+}
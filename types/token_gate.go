@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTokenGate creates a new TokenGate instance
+func NewTokenGate(minHolding uint64, action GateAction) TokenGate {
+	return TokenGate{
+		MinHolding: minHolding,
+		Action:     action,
+	}
+}
+
+// GetMinHolding returns the number of nfts of every dependent denom a minter must hold
+func (g TokenGate) GetMinHolding() uint64 {
+	if g.MinHolding == 0 {
+		return 1
+	}
+	return g.MinHolding
+}
+
+// Validate checks the gate action is known
+func (g TokenGate) Validate() error {
+	if _, ok := GateAction_name[int32(g.Action)]; !ok {
+		return sdkerrors.Wrapf(ErrTokenGate, "unknown gate action %d", g.Action)
+	}
+	return nil
+}
+
+// ParseGateAction parses a gate action from its name, e.g. hold, burn or lock
+func ParseGateAction(action string) (GateAction, error) {
+	if len(action) == 0 {
+		return GateHold, nil
+	}
+	value, ok := GateAction_value["GATE_ACTION_"+strings.ToUpper(strings.TrimSpace(action))]
+	if !ok {
+		return GateHold, sdkerrors.Wrapf(ErrTokenGate, "unknown gate action %s", action)
+	}
+	return GateAction(value), nil
+}
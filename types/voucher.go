@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSignBytes returns the bytes the denom creator signs to issue the voucher
+func (v MintVoucher) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&v)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetPrice returns the price the redeemer pays for the voucher
+func (v MintVoucher) GetPrice() (sdk.Coin, error) {
+	if len(v.Price) == 0 {
+		return sdk.Coin{}, nil
+	}
+
+	price, err := sdk.ParseCoinNormalized(v.Price)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidVoucher, "unable to parse the price %s", err.Error())
+	}
+	return price, nil
+}
+
+// Validate performs basic validation of the voucher
+func (v MintVoucher) Validate() error {
+	if len(v.ChainId) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidVoucher, "voucher must have a chain id")
+	}
+
+	if err := ValidateDenomID(v.DenomId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(v.NftId); err != nil {
+		return err
+	}
+
+	if err := ValidateMediaURI(v.Metadata.MediaURI); err != nil {
+		return err
+	}
+
+	if err := ValidatePreviewURI(v.Metadata.PreviewURI); err != nil {
+		return err
+	}
+
+	if err := ValidateRoyaltyShares(v.RoyaltyShares); err != nil {
+		return err
+	}
+
+	if _, err := v.GetPrice(); err != nil {
+		return err
+	}
+
+	if v.ExpiresAt.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidVoucher, "voucher must have an expiry")
+	}
+	return nil
+}
@@ -0,0 +1,956 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nft/v1beta1/voucher.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintVoucher is signed off-chain by the creator of a denom to let anyone mint
+// the described nft by paying its price
+type MintVoucher struct {
+	ChainId       string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	DenomId       string         `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	NftId         string         `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty" yaml:"nft_id"`
+	Metadata      Metadata       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+	Data          string         `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Transferable  bool           `protobuf:"varint,6,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Royalties     string         `protobuf:"bytes,7,opt,name=royalties,proto3" json:"royalties,omitempty"`
+	RoyaltyShares []RoyaltyShare `protobuf:"bytes,8,rep,name=royalty_shares,json=royaltyShares,proto3" json:"royalty_shares" yaml:"royalty_shares"`
+	// price is paid to the creator by the redeemer. Empty makes the voucher free.
+	Price     string    `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	ExpiresAt time.Time `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at" yaml:"expires_at"`
+	// nonce makes every voucher of a denom single use
+	Nonce uint64 `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MintVoucher) Reset()         { *m = MintVoucher{} }
+func (m *MintVoucher) String() string { return proto.CompactTextString(m) }
+func (*MintVoucher) ProtoMessage()    {}
+func (*MintVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dea3938b8351a1, []int{0}
+}
+func (m *MintVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVoucher.Merge(m, src)
+}
+func (m *MintVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintVoucher proto.InternalMessageInfo
+
+// RedeemedVoucher records the nonce of a redeemed voucher
+type RedeemedVoucher struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *RedeemedVoucher) Reset()         { *m = RedeemedVoucher{} }
+func (m *RedeemedVoucher) String() string { return proto.CompactTextString(m) }
+func (*RedeemedVoucher) ProtoMessage()    {}
+func (*RedeemedVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dea3938b8351a1, []int{1}
+}
+func (m *RedeemedVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeemedVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeemedVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedeemedVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemedVoucher.Merge(m, src)
+}
+func (m *RedeemedVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeemedVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemedVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemedVoucher proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MintVoucher)(nil), "nft.v1beta1.MintVoucher")
+	proto.RegisterType((*RedeemedVoucher)(nil), "nft.v1beta1.RedeemedVoucher")
+}
+
+func init() { proto.RegisterFile("nft/v1beta1/voucher.proto", fileDescriptor_69dea3938b8351a1) }
+
+var fileDescriptor_69dea3938b8351a1 = []byte{
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xdb, 0x3e,
+	0x10, 0xc7, 0xcd, 0xc4, 0x7f, 0xe9, 0x5f, 0x7e, 0x41, 0xd8, 0x18, 0x50, 0x8c, 0x56, 0x32, 0x84,
+	0x0e, 0x9a, 0x24, 0x24, 0x1d, 0x0a, 0x74, 0x8b, 0x37, 0x0f, 0xe9, 0xc0, 0x16, 0x6d, 0xd1, 0xc5,
+	0xa0, 0xad, 0x93, 0x2d, 0xd4, 0x22, 0x0d, 0x8a, 0x4e, 0xab, 0x87, 0x28, 0x90, 0xc7, 0xf2, 0x98,
+	0xb1, 0x93, 0xdb, 0xda, 0x6f, 0xe0, 0x27, 0x28, 0x48, 0xda, 0x8e, 0xdc, 0xa9, 0xdb, 0xdd, 0xf7,
+	0x3e, 0x77, 0x5f, 0x92, 0x47, 0x7c, 0xc5, 0x13, 0x15, 0xdd, 0x5f, 0x8f, 0x40, 0xb1, 0xeb, 0xe8,
+	0x5e, 0x2c, 0xc6, 0x53, 0x90, 0xe1, 0x5c, 0x0a, 0x25, 0x48, 0x9b, 0x27, 0x2a, 0xdc, 0x95, 0xba,
+	0x97, 0x13, 0x31, 0x11, 0x46, 0x8f, 0x74, 0x64, 0x91, 0xae, 0x37, 0x11, 0x62, 0x32, 0x83, 0xc8,
+	0x64, 0xa3, 0x45, 0x12, 0xa9, 0x34, 0x83, 0x5c, 0xb1, 0x6c, 0xbe, 0x03, 0x3a, 0xe5, 0xf1, 0x7a,
+	0x9e, 0x91, 0xfd, 0xef, 0x55, 0xdc, 0xbe, 0x4b, 0xb9, 0xfa, 0x60, 0x0d, 0x49, 0x88, 0x9b, 0xe3,
+	0x29, 0x4b, 0xf9, 0x30, 0x8d, 0x1d, 0xd4, 0x43, 0x41, 0xab, 0xff, 0x6c, 0xbb, 0xf2, 0xce, 0x0b,
+	0x96, 0xcd, 0xde, 0xf8, 0xfb, 0x8a, 0x4f, 0x1b, 0x26, 0x1c, 0xc4, 0x9a, 0x8f, 0x81, 0x8b, 0x4c,
+	0xf3, 0x27, 0x7f, 0xf3, 0xfb, 0x8a, 0x4f, 0x1b, 0x26, 0x1c, 0xc4, 0x24, 0xc0, 0x75, 0x9e, 0x28,
+	0x4d, 0x9f, 0x1a, 0xfa, 0x62, 0xbb, 0xf2, 0xce, 0x2c, 0x6d, 0x75, 0x9f, 0xd6, 0x78, 0xa2, 0x06,
+	0x31, 0x79, 0x8d, 0x9b, 0x19, 0x28, 0x16, 0x33, 0xc5, 0x9c, 0x6a, 0x0f, 0x05, 0xed, 0x9b, 0x4e,
+	0x58, 0x7a, 0x87, 0xf0, 0x6e, 0x57, 0xec, 0x57, 0x97, 0x2b, 0xaf, 0x42, 0x0f, 0x30, 0x21, 0xb8,
+	0x6a, 0x9a, 0x6a, 0xda, 0x80, 0x9a, 0x98, 0xf8, 0xf8, 0x3f, 0x25, 0x19, 0xcf, 0x13, 0x90, 0x6c,
+	0x34, 0x03, 0xa7, 0xde, 0x43, 0x41, 0x93, 0x1e, 0x69, 0xe4, 0x39, 0x6e, 0x49, 0x51, 0xb0, 0x99,
+	0x4a, 0x21, 0x77, 0x1a, 0xa6, 0xf9, 0x49, 0x20, 0x43, 0xfc, 0xbf, 0x4d, 0x8a, 0x61, 0x3e, 0x65,
+	0x12, 0x72, 0xa7, 0xd9, 0x3b, 0x0d, 0xda, 0x37, 0x57, 0x47, 0x87, 0xa2, 0x16, 0x79, 0xa7, 0x89,
+	0xfe, 0x0b, 0x7d, 0xb0, 0xed, 0xca, 0xeb, 0xd8, 0xfb, 0x1d, 0xb7, 0xfb, 0xf4, 0x4c, 0x96, 0xe0,
+	0x9c, 0x5c, 0xe2, 0xda, 0x5c, 0xa6, 0x63, 0x70, 0x5a, 0xc6, 0xda, 0x26, 0xe4, 0x13, 0xc6, 0xf0,
+	0x6d, 0x9e, 0x4a, 0xc8, 0x87, 0x4c, 0x39, 0xd8, 0xbc, 0x43, 0x37, 0xb4, 0xcb, 0x0e, 0xf7, 0xcb,
+	0x0e, 0xdf, 0xef, 0x97, 0x7d, 0xf0, 0xbc, 0xb0, 0x9e, 0x4f, 0xbd, 0xfe, 0xc3, 0x4f, 0x0f, 0xd1,
+	0xd6, 0x4e, 0xb8, 0x55, 0xda, 0x8f, 0x0b, 0x3e, 0x06, 0xa7, 0xdd, 0x43, 0x41, 0x95, 0xda, 0xc4,
+	0xff, 0x88, 0xcf, 0x29, 0xc4, 0x00, 0x19, 0xc4, 0xa5, 0x2f, 0x71, 0x58, 0x31, 0xfa, 0x87, 0x15,
+	0x1f, 0x06, 0x9f, 0x94, 0x06, 0xf7, 0xfb, 0xcb, 0xdf, 0x6e, 0x65, 0xb9, 0x76, 0xd1, 0xe3, 0xda,
+	0x45, 0xbf, 0xd6, 0x2e, 0x7a, 0xd8, 0xb8, 0x95, 0xc7, 0x8d, 0x5b, 0xf9, 0xb1, 0x71, 0x2b, 0x9f,
+	0x5f, 0x4e, 0x52, 0x35, 0x5d, 0x8c, 0xc2, 0xb1, 0xc8, 0xa2, 0xdb, 0x85, 0x12, 0x5c, 0x64, 0xc5,
+	0x5b, 0x50, 0x5f, 0x85, 0xfc, 0xa2, 0x3f, 0x6b, 0xa4, 0x8a, 0x39, 0xe4, 0xa3, 0xba, 0xb9, 0xf0,
+	0xab, 0x3f, 0x03, 0x00, 0xc0, 0xd2, 0xd0, 0x2b, 0x2b, 0x03, 0x00, 0x00,
+}
+
+func (m *MintVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintVoucher(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVoucher(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RoyaltyShares) > 0 {
+		for iNdEx := len(m.RoyaltyShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoucher(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Royalties) > 0 {
+		i -= len(m.Royalties)
+		copy(dAtA[i:], m.Royalties)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.Royalties)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVoucher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeemedVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemedVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemedVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintVoucher(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoucher(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoucher(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovVoucher(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	l = len(m.Royalties)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	if len(m.RoyaltyShares) > 0 {
+		for _, e := range m.RoyaltyShares {
+			l = e.Size()
+			n += 1 + l + sovVoucher(uint64(l))
+		}
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovVoucher(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovVoucher(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *RedeemedVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovVoucher(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovVoucher(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoucher(x uint64) (n int) {
+	return sovVoucher(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoucher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyShares = append(m.RoyaltyShares, RoyaltyShare{})
+			if err := m.RoyaltyShares[len(m.RoyaltyShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoucher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemedVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoucher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemedVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemedVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoucher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoucher(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoucher
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoucher
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoucher
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoucher
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoucher        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoucher          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoucher = fmt.Errorf("proto: unexpected end of group")
+)